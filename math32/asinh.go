@@ -0,0 +1,126 @@
+package math32
+
+// Asinh returns the inverse hyperbolic sine of x.
+//
+// Special cases are:
+//
+//	Asinh(±0) = ±0
+//	Asinh(±Inf) = ±Inf
+//	Asinh(NaN) = NaN
+func Asinh(x float32) float32 {
+	const (
+		large    = 1 << 12         // x*x + 1 == x*x above 2^12
+		nearZero = 1.0 / (1 << 12) // asinh(x) == x below 2^-12
+	)
+
+	// Handle special cases
+	if x == 0 || IsNaN(x) || IsInf(x, 0) {
+		return x // preserves sign of zero
+	}
+
+	// asinh is odd
+	neg := false
+	if x < 0 {
+		x = -x
+		neg = true
+	}
+
+	var result float32
+	switch {
+	case x > large:
+		// asinh(x) ≈ log(2x), split to avoid overflow
+		result = Log(x) + Ln2
+	case x > 2:
+		result = Log(2*x + 1/(Sqrt(x*x+1)+x))
+	case x < nearZero:
+		result = x
+	default:
+		// log(x + sqrt(x²+1)) = log1p(x + x²/(1 + sqrt(1+x²)))
+		result = Log1p(x + x*x/(1+Sqrt(1+x*x)))
+	}
+
+	if neg {
+		return -result
+	}
+	return result
+}
+
+// Acosh returns the inverse hyperbolic cosine of x.
+//
+// Special cases are:
+//
+//	Acosh(+Inf) = +Inf
+//	Acosh(x) = NaN if x < 1
+//	Acosh(NaN) = NaN
+func Acosh(x float32) float32 {
+	const large = 1 << 12 // x*x - 1 == x*x above 2^12
+
+	// Handle special cases
+	if x < 1 || IsNaN(x) {
+		return NaN()
+	}
+
+	switch {
+	case x == 1:
+		return 0
+	case x > large:
+		// acosh(x) ≈ log(2x), split to avoid overflow (also covers +Inf)
+		return Log(x) + Ln2
+	case x > 2:
+		return Log(2*x - 1/(x+Sqrt(x*x-1)))
+	}
+
+	// log(x + sqrt(x²-1)) = log1p(t + sqrt(2t + t²)) with t = x - 1, exact here
+	t := x - 1
+	return Log1p(t + Sqrt(2*t+t*t))
+}
+
+// Atanh returns the inverse hyperbolic tangent of x.
+//
+// Special cases are:
+//
+//	Atanh(1) = +Inf
+//	Atanh(±0) = ±0
+//	Atanh(-1) = -Inf
+//	Atanh(x) = NaN if x < -1 or x > 1
+//	Atanh(NaN) = NaN
+func Atanh(x float32) float32 {
+	const nearZero = 1.0 / (1 << 12) // atanh(x) == x below 2^-12
+
+	// Handle special cases
+	if x < -1 || x > 1 || IsNaN(x) {
+		return NaN()
+	}
+
+	if x == 1 {
+		return Inf(1)
+	}
+
+	if x == -1 {
+		return Inf(-1)
+	}
+
+	// atanh is odd
+	neg := false
+	if x < 0 {
+		x = -x
+		neg = true
+	}
+
+	// atanh(x) = log((1+x)/(1-x))/2 = log1p(2x/(1-x))/2
+	var result float32
+	switch {
+	case x < nearZero:
+		result = x // also preserves the sign of zero
+	case x < 0.5:
+		t := x + x
+		result = 0.5 * Log1p(t+t*x/(1-x))
+	default:
+		result = 0.5 * Log1p((x+x)/(1-x))
+	}
+
+	if neg {
+		return -result
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+package math32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAsinh(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+		{"+Inf", Inf(1), Inf(1)},
+		{"-Inf", Inf(-1), Inf(-1)},
+		{"NaN", NaN(), NaN()},
+
+		// Tiny arguments return themselves
+		{"1e-10", 1e-10, 1e-10},
+		{"-1e-10", -1e-10, -1e-10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Asinh(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Asinh(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAcosh(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"one", 1, 0},
+		{"+Inf", Inf(1), Inf(1)},
+		{"below one", 0.5, NaN()},
+		{"zero", 0, NaN()},
+		{"-Inf", Inf(-1), NaN()},
+		{"NaN", NaN(), NaN()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Acosh(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Acosh(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAtanh(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+		{"one", 1, Inf(1)},
+		{"minus one", -1, Inf(-1)},
+		{"above one", 1.5, NaN()},
+		{"below minus one", -1.5, NaN()},
+		{"+Inf", Inf(1), NaN()},
+		{"NaN", NaN(), NaN()},
+
+		// Tiny arguments return themselves
+		{"1e-10", 1e-10, 1e-10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Atanh(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Atanh(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInverseHyperbolicULP(t *testing.T) {
+	tests := []struct {
+		name   string
+		f      func(float32) float32
+		ref    func(float64) float64
+		inputs []float32
+		maxULP uint32
+	}{
+		{"Asinh", Asinh, math.Asinh, magnitudeInputs(100000, -45, 38), 5},
+		{"Asinh near zero", Asinh, math.Asinh, uniformInputs(100000, -5, 5), 5},
+		{"Acosh", Acosh, math.Acosh, magnitudeInputs(100000, 0, 38), 5},
+		{"Acosh near one", Acosh, math.Acosh, uniformInputs(100000, 1, 5), 5},
+		{"Atanh", Atanh, math.Atanh, uniformInputs(100000, -1, 1), 5},
+		{"Atanh tiny", Atanh, math.Atanh, magnitudeInputs(100000, -45, 0), 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkULP(t, tt.name, tt.f, tt.ref, tt.inputs, tt.maxULP)
+		})
+	}
+}
+
+func TestInverseHyperbolicRoundTrip(t *testing.T) {
+	for _, x := range []float32{0.1, 0.5, 1, 2, 5, 10} {
+		if got := Asinh(Sinh(x)); Abs(got-x) > 1e-5*x {
+			t.Errorf("Asinh(Sinh(%v)) = %v", x, got)
+		}
+		if got := Acosh(Cosh(x)); Abs(got-x) > 1e-5*x+1e-3 {
+			t.Errorf("Acosh(Cosh(%v)) = %v", x, got)
+		}
+		if x < 5 {
+			if got := Atanh(Tanh(x)); Abs(got-x) > 1e-4*x {
+				t.Errorf("Atanh(Tanh(%v)) = %v", x, got)
+			}
+		}
+	}
+}
+
+func BenchmarkAsinh(b *testing.B) {
+	x := float32(0.5)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Asinh(x)
+	}
+	_ = result
+}
+
+func BenchmarkAtanh(b *testing.B) {
+	x := float32(0.5)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Atanh(x)
+	}
+	_ = result
+}
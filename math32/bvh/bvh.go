@@ -0,0 +1,331 @@
+// Package bvh implements a bounding volume hierarchy to accelerate ray queries over arbitrary primitives.
+//
+// The hierarchy is built top-down using the binned surface area heuristic (SAH) and then
+// flattened into a depth-first array of nodes for cache-friendly traversal.
+package bvh
+
+import (
+	"sync"
+
+	"github.com/flynn-nrg/go-vfx/math32/geom"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+const (
+	// numBins is the number of buckets used to evaluate the SAH along the split axis.
+	numBins = 12
+	// maxPrimsInLeaf is the largest number of primitives a leaf can hold.
+	maxPrimsInLeaf = 4
+	// traversalCost is the cost of visiting an interior node relative to intersecting a primitive.
+	traversalCost = 0.125
+	// parallelThreshold is the smallest number of primitives for which a subtree is built in its own goroutine.
+	parallelThreshold = 4096
+)
+
+// Primitive is the interface that must be implemented by the objects stored in a BVH.
+type Primitive interface {
+	// Bounds returns the bounding box of this primitive.
+	Bounds() geom.AABB
+	// Intersect returns the distance to the closest hit within the ray's parametric range
+	// and whether there was such a hit.
+	Intersect(r geom.Ray) (float32, bool)
+}
+
+// BVH represents a bounding volume hierarchy over a set of primitives.
+type BVH struct {
+	nodes      []linearNode
+	primitives []Primitive
+}
+
+// linearNode is a node of the flattened tree.
+// The first child of an interior node immediately follows it in the array.
+type linearNode struct {
+	bounds geom.AABB
+	// offset is the index of the first primitive for leaves and of the second child for interior nodes.
+	offset int32
+	// numPrims is the number of primitives of a leaf and 0 for interior nodes.
+	numPrims uint16
+	// axis is the split axis of an interior node.
+	axis uint8
+}
+
+// buildNode is a node of the tree used during construction.
+type buildNode struct {
+	bounds    geom.AABB
+	children  [2]*buildNode
+	axis      int
+	firstPrim int
+	numPrims  int
+	nodeCount int
+}
+
+// buildPrim caches the bounds and centroid of a primitive during construction.
+type buildPrim struct {
+	index    int
+	bounds   geom.AABB
+	centroid vec3.Vec3Impl
+}
+
+// bin accumulates the primitives falling into one SAH bucket.
+type bin struct {
+	count  int
+	bounds geom.AABB
+}
+
+// New returns a new BVH built over the supplied primitives.
+// Subtrees with many primitives are built concurrently.
+func New(primitives []Primitive) *BVH {
+	b := &BVH{}
+	if len(primitives) == 0 {
+		return b
+	}
+
+	prims := make([]buildPrim, len(primitives))
+	for i, p := range primitives {
+		bounds := p.Bounds()
+		prims[i] = buildPrim{
+			index:    i,
+			bounds:   bounds,
+			centroid: bounds.Centroid(),
+		}
+	}
+
+	root := build(prims, 0)
+
+	b.primitives = make([]Primitive, len(primitives))
+	for i := range prims {
+		b.primitives[i] = primitives[prims[i].index]
+	}
+
+	b.nodes = make([]linearNode, 0, root.nodeCount)
+	b.flatten(root)
+
+	return b
+}
+
+// Bounds returns the bounding box of all the primitives in this BVH.
+func (b *BVH) Bounds() geom.AABB {
+	if len(b.nodes) == 0 {
+		return geom.EmptyAABB()
+	}
+
+	return b.nodes[0].bounds
+}
+
+// Intersect returns the closest primitive hit by the ray within its parametric range,
+// together with the hit distance. The boolean result is false if nothing was hit.
+func (b *BVH) Intersect(r geom.Ray) (Primitive, float32, bool) {
+	if len(b.nodes) == 0 {
+		return nil, 0, false
+	}
+
+	dirIsNeg := [3]bool{r.InvDirection.X < 0, r.InvDirection.Y < 0, r.InvDirection.Z < 0}
+
+	var closest Primitive
+	stack := make([]int32, 0, 64)
+	current := int32(0)
+
+	for {
+		node := &b.nodes[current]
+		if hit, _, _ := node.bounds.Hit(r); hit {
+			if node.numPrims > 0 {
+				for i := node.offset; i < node.offset+int32(node.numPrims); i++ {
+					if t, ok := b.primitives[i].Intersect(r); ok {
+						r.TMax = t
+						closest = b.primitives[i]
+					}
+				}
+				if len(stack) == 0 {
+					break
+				}
+				current = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			// Visit the near child first so that TMax shrinks as early as possible.
+			if dirIsNeg[node.axis] {
+				stack = append(stack, current+1)
+				current = node.offset
+			} else {
+				stack = append(stack, node.offset)
+				current = current + 1
+			}
+			continue
+		}
+
+		if len(stack) == 0 {
+			break
+		}
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+	}
+
+	if closest == nil {
+		return nil, 0, false
+	}
+
+	return closest, r.TMax, true
+}
+
+// build recursively builds the subtree for the supplied primitives, reordering them in place.
+func build(prims []buildPrim, first int) *buildNode {
+	bounds := geom.EmptyAABB()
+	centroidBounds := geom.EmptyAABB()
+	for i := range prims {
+		bounds = geom.Union(bounds, prims[i].bounds)
+		centroidBounds = geom.Expand(centroidBounds, prims[i].centroid)
+	}
+
+	leaf := &buildNode{
+		bounds:    bounds,
+		firstPrim: first,
+		numPrims:  len(prims),
+		nodeCount: 1,
+	}
+
+	if len(prims) == 1 {
+		return leaf
+	}
+
+	axis := centroidBounds.MaximumExtent()
+	cMin := component(centroidBounds.Min, axis)
+	cMax := component(centroidBounds.Max, axis)
+	if cMax == cMin {
+		// All centroids coincide, so no split can separate them.
+		if len(prims) <= maxPrimsInLeaf {
+			return leaf
+		}
+		return split(prims, first, bounds, axis, len(prims)/2)
+	}
+
+	// Bin the centroids along the split axis.
+	var bins [numBins]bin
+	for i := range bins {
+		bins[i].bounds = geom.EmptyAABB()
+	}
+
+	scale := numBins / (cMax - cMin)
+	for i := range prims {
+		b := binIndex(component(prims[i].centroid, axis), cMin, scale)
+		bins[b].count++
+		bins[b].bounds = geom.Union(bins[b].bounds, prims[i].bounds)
+	}
+
+	// Sweep from both ends to evaluate the SAH cost of splitting after each bin.
+	var rightArea [numBins - 1]float32
+	var rightCount [numBins - 1]int
+	acc := geom.EmptyAABB()
+	count := 0
+	for i := numBins - 1; i > 0; i-- {
+		acc = geom.Union(acc, bins[i].bounds)
+		count += bins[i].count
+		rightArea[i-1] = acc.SurfaceArea()
+		rightCount[i-1] = count
+	}
+
+	bestCost := float32(-1)
+	bestSplit := 0
+	acc = geom.EmptyAABB()
+	count = 0
+	for i := 0; i < numBins-1; i++ {
+		acc = geom.Union(acc, bins[i].bounds)
+		count += bins[i].count
+		cost := float32(count)*acc.SurfaceArea() + float32(rightCount[i])*rightArea[i]
+		if bestCost < 0 || cost < bestCost {
+			bestCost = cost
+			bestSplit = i
+		}
+	}
+
+	invArea := 1 / bounds.SurfaceArea()
+	leafCost := float32(len(prims))
+	splitCost := traversalCost + bestCost*invArea
+	if len(prims) <= maxPrimsInLeaf && leafCost <= splitCost {
+		return leaf
+	}
+
+	// Partition the primitives around the chosen bin boundary.
+	mid := 0
+	for i := range prims {
+		if binIndex(component(prims[i].centroid, axis), cMin, scale) <= bestSplit {
+			prims[i], prims[mid] = prims[mid], prims[i]
+			mid++
+		}
+	}
+
+	if mid == 0 || mid == len(prims) {
+		mid = len(prims) / 2
+	}
+
+	return split(prims, first, bounds, axis, mid)
+}
+
+// split builds the two children of an interior node, concurrently for large subtrees.
+func split(prims []buildPrim, first int, bounds geom.AABB, axis int, mid int) *buildNode {
+	node := &buildNode{
+		bounds: bounds,
+		axis:   axis,
+	}
+
+	if len(prims) >= parallelThreshold {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			node.children[0] = build(prims[:mid], first)
+		}()
+		node.children[1] = build(prims[mid:], first+mid)
+		wg.Wait()
+	} else {
+		node.children[0] = build(prims[:mid], first)
+		node.children[1] = build(prims[mid:], first+mid)
+	}
+
+	node.nodeCount = 1 + node.children[0].nodeCount + node.children[1].nodeCount
+
+	return node
+}
+
+// flatten appends the subtree rooted at node to the linear node array in depth-first order.
+func (b *BVH) flatten(node *buildNode) int32 {
+	index := int32(len(b.nodes))
+	b.nodes = append(b.nodes, linearNode{bounds: node.bounds})
+
+	if node.numPrims > 0 {
+		b.nodes[index].offset = int32(node.firstPrim)
+		b.nodes[index].numPrims = uint16(node.numPrims)
+		return index
+	}
+
+	b.nodes[index].axis = uint8(node.axis)
+	b.flatten(node.children[0])
+	b.nodes[index].offset = b.flatten(node.children[1])
+
+	return index
+}
+
+// binIndex returns the SAH bucket of a centroid coordinate.
+func binIndex(c, cMin, scale float32) int {
+	b := int((c - cMin) * scale)
+	if b >= numBins {
+		b = numBins - 1
+	}
+	if b < 0 {
+		b = 0
+	}
+
+	return b
+}
+
+// component returns the i-th component of v, where 0 is X, 1 is Y and 2 is Z.
+func component(v vec3.Vec3Impl, i int) float32 {
+	switch i {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	default:
+		return v.Z
+	}
+}
@@ -0,0 +1,183 @@
+package bvh
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/fastrandom"
+	"github.com/flynn-nrg/go-vfx/math32/geom"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+type triangle struct {
+	v0, v1, v2 vec3.Vec3Impl
+}
+
+func (tri *triangle) Bounds() geom.AABB {
+	return geom.NewAABBFromTriangle(tri.v0, tri.v1, tri.v2)
+}
+
+func (tri *triangle) Intersect(r geom.Ray) (float32, bool) {
+	hit, ok := geom.IntersectTriangleWatertight(r, tri.v0, tri.v1, tri.v2)
+	return hit.T, ok
+}
+
+type sphere struct {
+	center vec3.Vec3Impl
+	radius float32
+}
+
+func (s *sphere) Bounds() geom.AABB {
+	r := vec3.Vec3Impl{X: s.radius, Y: s.radius, Z: s.radius}
+	return geom.NewAABB(vec3.Sub(s.center, r), vec3.Add(s.center, r))
+}
+
+func (s *sphere) Intersect(r geom.Ray) (float32, bool) {
+	return geom.IntersectSphere(r, s.center, s.radius)
+}
+
+func randomPoint(random *fastrandom.XorShift, scale float32) vec3.Vec3Impl {
+	return vec3.Vec3Impl{
+		X: (random.Float32() - 0.5) * scale,
+		Y: (random.Float32() - 0.5) * scale,
+		Z: (random.Float32() - 0.5) * scale,
+	}
+}
+
+func randomTriangles(n int, random *fastrandom.XorShift) []Primitive {
+	prims := make([]Primitive, n)
+	for i := range prims {
+		c := randomPoint(random, 100)
+		prims[i] = &triangle{
+			v0: vec3.Add(c, randomPoint(random, 2)),
+			v1: vec3.Add(c, randomPoint(random, 2)),
+			v2: vec3.Add(c, randomPoint(random, 2)),
+		}
+	}
+	return prims
+}
+
+func randomRays(n int, random *fastrandom.XorShift) []geom.Ray {
+	rays := make([]geom.Ray, n)
+	for i := range rays {
+		rays[i] = geom.NewInfiniteRay(randomPoint(random, 150), vec3.UnitVector(randomPoint(random, 2)))
+	}
+	return rays
+}
+
+// bruteForce returns the closest hit by testing every primitive.
+func bruteForce(prims []Primitive, r geom.Ray) (Primitive, float32, bool) {
+	var closest Primitive
+	for _, p := range prims {
+		if t, ok := p.Intersect(r); ok {
+			r.TMax = t
+			closest = p
+		}
+	}
+	if closest == nil {
+		return nil, 0, false
+	}
+	return closest, r.TMax, true
+}
+
+func TestIntersectMatchesBruteForce(t *testing.T) {
+	random := fastrandom.New(42)
+
+	testData := []struct {
+		name  string
+		prims []Primitive
+	}{
+		{
+			name:  "Single triangle",
+			prims: randomTriangles(1, random),
+		},
+		{
+			name:  "Few triangles",
+			prims: randomTriangles(7, random),
+		},
+		{
+			name:  "Many triangles built in parallel",
+			prims: randomTriangles(3*parallelThreshold, random),
+		},
+		{
+			name: "Mixed primitives",
+			prims: append(randomTriangles(500, random),
+				&sphere{center: vec3.Vec3Impl{X: 3}, radius: 4},
+				&sphere{center: vec3.Vec3Impl{Y: -20, Z: 5}, radius: 10},
+			),
+		},
+		{
+			name: "Coincident centroids",
+			prims: func() []Primitive {
+				prims := make([]Primitive, 20)
+				for i := range prims {
+					prims[i] = &sphere{radius: float32(i + 1)}
+				}
+				return prims
+			}(),
+		},
+	}
+
+	rays := randomRays(2000, random)
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			b := New(test.prims)
+			for _, r := range rays {
+				wantPrim, wantT, wantOK := bruteForce(test.prims, r)
+				gotPrim, gotT, gotOK := b.Intersect(r)
+				if gotOK != wantOK || gotPrim != wantPrim || gotT != wantT {
+					t.Fatalf("Intersect(%+v) = (%v, %v, %v), want (%v, %v, %v)", r, gotPrim, gotT, gotOK, wantPrim, wantT, wantOK)
+				}
+			}
+		})
+	}
+}
+
+func TestBounds(t *testing.T) {
+	random := fastrandom.New(7)
+	prims := randomTriangles(1000, random)
+
+	want := geom.EmptyAABB()
+	for _, p := range prims {
+		want = geom.Union(want, p.Bounds())
+	}
+
+	if got := New(prims).Bounds(); got != want {
+		t.Errorf("Bounds() = %+v, want %+v", got, want)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	b := New(nil)
+	if _, _, ok := b.Intersect(geom.NewInfiniteRay(vec3.Vec3Impl{}, vec3.Vec3Impl{Z: 1})); ok {
+		t.Errorf("Intersect() on an empty BVH reported a hit")
+	}
+	if !b.Bounds().IsEmpty() {
+		t.Errorf("Bounds() = %+v, want an empty box", b.Bounds())
+	}
+}
+
+func BenchmarkBuild(b *testing.B) {
+	prims := randomTriangles(100000, fastrandom.New(12345))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if New(prims) == nil {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
+func BenchmarkIntersect(b *testing.B) {
+	random := fastrandom.New(12345)
+	bvh := New(randomTriangles(100000, random))
+	rays := randomRays(1024, random)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, t, _ := bvh.Intersect(rays[i%len(rays)])
+		if t < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package math32
+
+import "math"
+
+// Cbrt returns the cube root of x.
+//
+// Special cases are:
+//
+//	Cbrt(±0) = ±0
+//	Cbrt(±Inf) = ±Inf
+//	Cbrt(NaN) = NaN
+func Cbrt(x float32) float32 {
+	// Magic constants to divide the exponent by three, from FreeBSD's cbrtf.
+	const (
+		B1 = 709958130 // (127-127.0/3-0.03306235651)*2**23
+		B2 = 642849266 // (127-127.0/3-24/3-0.03306235651)*2**23
+	)
+
+	// Handle special cases
+	if x == 0 || IsNaN(x) || IsInf(x, 0) {
+		return x
+	}
+
+	neg := false
+	if x < 0 {
+		x = -x
+		neg = true
+	}
+
+	// Rough cube root to about 5 bits by dividing the exponent by three
+	bits := math.Float32bits(x)
+	var t float32
+	if bits < 0x00800000 {
+		// Denormal - scale by 2^24 first and compensate in the magic constant
+		t = x * (1 << 24)
+		t = math.Float32frombits(math.Float32bits(t)/3 + B2)
+	} else {
+		t = math.Float32frombits(bits/3 + B1)
+	}
+
+	// Newton iterations for t³ = x, each doubling the number of correct bits.
+	// The form t - (t - x/t²)/3 cannot overflow.
+	for i := 0; i < 3; i++ {
+		t -= (t - x/(t*t)) / 3
+	}
+
+	if neg {
+		return -t
+	}
+	return t
+}
@@ -0,0 +1,52 @@
+package math32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCbrt(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"NaN", NaN(), NaN()},
+		{"+Inf", Inf(1), Inf(1)},
+		{"-Inf", Inf(-1), Inf(-1)},
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+
+		// Perfect cubes are exact
+		{"1", 1, 1},
+		{"8", 8, 2},
+		{"27", 27, 3},
+		{"-27", -27, -3},
+		{"1000", 1000, 10},
+		{"0.125", 0.125, 0.5},
+		{"2^-147", 0x1p-147, 0x1p-49},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cbrt(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Cbrt(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCbrtULP(t *testing.T) {
+	checkULP(t, "Cbrt", Cbrt, math.Cbrt, magnitudeInputs(100000, -45, 38), 1)
+	checkULP(t, "Cbrt", Cbrt, math.Cbrt, uniformInputs(100000, -10, 10), 1)
+}
+
+func BenchmarkCbrt(b *testing.B) {
+	x := float32(10.0)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Cbrt(x)
+	}
+	_ = result
+}
@@ -0,0 +1,43 @@
+package colour
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// Cone response matrices for von Kries chromatic adaptation. Each converts CIE XYZ to the
+// space in which the adaptation scales the three responses independently.
+var (
+	// Bradford is the cone response matrix of the Bradford transform, used by ICC profiles
+	// and by ACES for white point conversions.
+	Bradford = mat3.Mat3{
+		A11: 0.8951, A12: 0.2664, A13: -0.1614,
+		A21: -0.7502, A22: 1.7135, A23: 0.0367,
+		A31: 0.0389, A32: -0.0685, A33: 1.0296,
+	}
+	// CAT02 is the cone response matrix of the CIECAM02 colour appearance model.
+	CAT02 = mat3.Mat3{
+		A11: 0.7328, A12: 0.4296, A13: -0.1624,
+		A21: -0.7036, A22: 1.6975, A23: 0.0061,
+		A31: 0.0030, A32: 0.0136, A33: 0.9834,
+	}
+	// XYZScaling scales the XYZ values directly. It is the crudest of the three.
+	XYZScaling = mat3.Identity()
+)
+
+// ChromaticAdaptation returns the matrix that converts CIE XYZ values seen under the src
+// white point to the corresponding values under the dst white point, using the supplied
+// cone response matrix.
+func ChromaticAdaptation(cone mat3.Mat3, src, dst Chromaticity) mat3.Mat3 {
+	if src == dst {
+		return mat3.Identity()
+	}
+
+	s := mat3.MatrixVectorMul(cone, src.XYZ())
+	d := mat3.MatrixVectorMul(cone, dst.XYZ())
+	scale := diagonal(vec3.Div(d, s))
+
+	inv, _ := mat3.Inverse(cone)
+
+	return mat3.MatrixMul(inv, mat3.MatrixMul(scale, cone))
+}
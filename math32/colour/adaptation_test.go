@@ -0,0 +1,66 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestChromaticAdaptation(t *testing.T) {
+	testData := []struct {
+		name string
+		cone mat3.Mat3
+	}{
+		{name: "Bradford", cone: Bradford},
+		{name: "CAT02", cone: CAT02},
+		{name: "XYZ scaling", cone: XYZScaling},
+	}
+
+	whites := []struct {
+		name  string
+		white Chromaticity
+	}{
+		{name: "D50", white: D50},
+		{name: "D65", white: D65},
+		{name: "ACES", white: ACESWhite},
+		{name: "DCI", white: DCIWhite},
+	}
+
+	for _, test := range testData {
+		for _, src := range whites {
+			for _, dst := range whites {
+				t.Run(test.name+" "+src.name+" to "+dst.name, func(t *testing.T) {
+					m := ChromaticAdaptation(test.cone, src.white, dst.white)
+
+					// The source white maps to the destination white.
+					got := mat3.MatrixVectorMul(m, src.white.XYZ())
+					if diff := cmp.Diff(dst.white.XYZ(), got, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+						t.Errorf("ChromaticAdaptation() x white mismatch (-want +got):\n%s", diff)
+					}
+
+					// Adapting back undoes the adaptation.
+					back := mat3.MatrixMul(ChromaticAdaptation(test.cone, dst.white, src.white), m)
+					if diff := cmp.Diff(mat3.Identity(), back, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+						t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestBradfordD65ToD50(t *testing.T) {
+	// Bruce Lindbloom's tabulated matrix, computed from slightly different white XYZ values.
+	want := mat3.Mat3{
+		A11: 1.0478112, A12: 0.0228866, A13: -0.0501270,
+		A21: 0.0295424, A22: 0.9904844, A23: -0.0170491,
+		A31: -0.0092345, A32: 0.0150436, A33: 0.7521316,
+	}
+
+	got := ChromaticAdaptation(Bradford, D65, D50)
+	if diff := cmp.Diff(want, got, cmpopts.EquateApprox(0, 5e-4)); diff != "" {
+		t.Errorf("ChromaticAdaptation() mismatch (-want +got):\n%s", diff)
+	}
+}
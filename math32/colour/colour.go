@@ -0,0 +1,109 @@
+// Package colour implements RGB colour spaces defined by their primaries and white point,
+// the matrices between them and CIE XYZ, and chromatic adaptation.
+package colour
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// Chromaticity is a CIE 1931 xy chromaticity coordinate.
+type Chromaticity struct {
+	X float32
+	Y float32
+}
+
+// Standard white points.
+var (
+	// D50 is the CIE standard illuminant D50, the white of the ICC profile connection space.
+	D50 = Chromaticity{X: 0.3457, Y: 0.3585}
+	// D65 is the CIE standard illuminant D65, the white of sRGB and Rec.2020.
+	D65 = Chromaticity{X: 0.3127, Y: 0.3290}
+	// ACESWhite is the white point of the ACES colour spaces, close to D60.
+	ACESWhite = Chromaticity{X: 0.32168, Y: 0.33767}
+	// DCIWhite is the white point of DCI-P3 projection.
+	DCIWhite = Chromaticity{X: 0.314, Y: 0.351}
+)
+
+// XYZ returns the CIE XYZ tristimulus values of the chromaticity with luminance Y = 1.
+func (c Chromaticity) XYZ() vec3.Vec3Impl {
+	return vec3.Vec3Impl{
+		X: c.X / c.Y,
+		Y: 1,
+		Z: (1 - c.X - c.Y) / c.Y,
+	}
+}
+
+// Primaries are the chromaticities of the red, green and blue primaries of an RGB colour space.
+type Primaries struct {
+	Red   Chromaticity
+	Green Chromaticity
+	Blue  Chromaticity
+}
+
+// Standard primaries.
+var (
+	// Rec709 are the primaries of ITU-R BT.709 and sRGB.
+	Rec709 = Primaries{
+		Red:   Chromaticity{X: 0.64, Y: 0.33},
+		Green: Chromaticity{X: 0.30, Y: 0.60},
+		Blue:  Chromaticity{X: 0.15, Y: 0.06},
+	}
+	// Rec2020 are the primaries of ITU-R BT.2020.
+	Rec2020 = Primaries{
+		Red:   Chromaticity{X: 0.708, Y: 0.292},
+		Green: Chromaticity{X: 0.170, Y: 0.797},
+		Blue:  Chromaticity{X: 0.131, Y: 0.046},
+	}
+	// P3 are the primaries of DCI-P3.
+	P3 = Primaries{
+		Red:   Chromaticity{X: 0.680, Y: 0.320},
+		Green: Chromaticity{X: 0.265, Y: 0.690},
+		Blue:  Chromaticity{X: 0.150, Y: 0.060},
+	}
+	// AP0 are the ACES primaries 0, which enclose the whole spectral locus.
+	AP0 = Primaries{
+		Red:   Chromaticity{X: 0.7347, Y: 0.2653},
+		Green: Chromaticity{X: 0.0, Y: 1.0},
+		Blue:  Chromaticity{X: 0.0001, Y: -0.0770},
+	}
+	// AP1 are the ACES primaries 1, used by ACEScg and ACEScct.
+	AP1 = Primaries{
+		Red:   Chromaticity{X: 0.713, Y: 0.293},
+		Green: Chromaticity{X: 0.165, Y: 0.830},
+		Blue:  Chromaticity{X: 0.128, Y: 0.044},
+	}
+)
+
+// RGBToXYZ returns the matrix that converts linear RGB values with the supplied primaries
+// to CIE XYZ. RGB white (1, 1, 1) maps to the white point with luminance Y = 1.
+func RGBToXYZ(p Primaries, white Chromaticity) mat3.Mat3 {
+	r, g, b := p.Red.XYZ(), p.Green.XYZ(), p.Blue.XYZ()
+	primaries := mat3.Mat3{
+		A11: r.X, A12: g.X, A13: b.X,
+		A21: r.Y, A22: g.Y, A23: b.Y,
+		A31: r.Z, A32: g.Z, A33: b.Z,
+	}
+
+	// Scale each primary so that together they add up to the white point.
+	inv, _ := mat3.Inverse(primaries)
+	s := mat3.MatrixVectorMul(inv, white.XYZ())
+
+	return mat3.MatrixMul(primaries, diagonal(s))
+}
+
+// XYZToRGB returns the matrix that converts CIE XYZ to linear RGB values with the supplied
+// primaries. It is the inverse of RGBToXYZ.
+func XYZToRGB(p Primaries, white Chromaticity) mat3.Mat3 {
+	inv, _ := mat3.Inverse(RGBToXYZ(p, white))
+	return inv
+}
+
+// diagonal returns the matrix with the components of v on its diagonal.
+func diagonal(v vec3.Vec3Impl) mat3.Mat3 {
+	return mat3.Mat3{
+		A11: v.X,
+		A22: v.Y,
+		A33: v.Z,
+	}
+}
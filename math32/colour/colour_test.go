@@ -0,0 +1,124 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestRGBToXYZ(t *testing.T) {
+	testData := []struct {
+		name      string
+		primaries Primaries
+		white     Chromaticity
+		// tolerance allows for the precision of the published matrices.
+		tolerance float64
+		want      mat3.Mat3
+	}{
+		{
+			// IEC 61966-2-1, which rounds to four decimals.
+			name:      "sRGB",
+			primaries: Rec709,
+			white:     D65,
+			tolerance: 1e-4,
+			want: mat3.Mat3{
+				A11: 0.4124, A12: 0.3576, A13: 0.1805,
+				A21: 0.2126, A22: 0.7152, A23: 0.0722,
+				A31: 0.0193, A32: 0.1192, A33: 0.9505,
+			},
+		},
+		{
+			// ITU-R BT.2087.
+			name:      "Rec.2020",
+			primaries: Rec2020,
+			white:     D65,
+			tolerance: 2e-6,
+			want: mat3.Mat3{
+				A11: 0.6369580, A12: 0.1446169, A13: 0.1688810,
+				A21: 0.2627002, A22: 0.6779981, A23: 0.0593017,
+				A31: 0.0000000, A32: 0.0280727, A33: 1.0609851,
+			},
+		},
+		{
+			// SMPTE RP 431-2.
+			name:      "DCI-P3",
+			primaries: P3,
+			white:     DCIWhite,
+			tolerance: 2e-6,
+			want: mat3.Mat3{
+				A11: 0.4451698, A12: 0.2771344, A13: 0.1722827,
+				A21: 0.2094917, A22: 0.7215953, A23: 0.0689131,
+				A31: 0.0000000, A32: 0.0470606, A33: 0.9073554,
+			},
+		},
+		{
+			// Academy TB-2014-004.
+			name:      "AP0",
+			primaries: AP0,
+			white:     ACESWhite,
+			tolerance: 2e-6,
+			want: mat3.Mat3{
+				A11: 0.9525523959, A12: 0.0000000000, A13: 0.0000936786,
+				A21: 0.3439664498, A22: 0.7281660966, A23: -0.0721325464,
+				A31: 0.0000000000, A32: 0.0000000000, A33: 1.0088251844,
+			},
+		},
+		{
+			// Academy TB-2014-004.
+			name:      "AP1",
+			primaries: AP1,
+			white:     ACESWhite,
+			tolerance: 2e-6,
+			want: mat3.Mat3{
+				A11: 0.6624541811, A12: 0.1340042065, A13: 0.1561876870,
+				A21: 0.2722287168, A22: 0.6740817658, A23: 0.0536895174,
+				A31: -0.0055746495, A32: 0.0040607335, A33: 1.0103391003,
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := RGBToXYZ(test.primaries, test.white)
+			if diff := cmp.Diff(test.want, got, cmpopts.EquateApprox(0, test.tolerance)); diff != "" {
+				t.Errorf("RGBToXYZ() mismatch (-want +got):\n%s", diff)
+			}
+
+			// RGB white maps to the white point.
+			white := mat3.MatrixVectorMul(got, vec3.Vec3Impl{X: 1, Y: 1, Z: 1})
+			if diff := cmp.Diff(test.white.XYZ(), white, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+				t.Errorf("RGBToXYZ() x white mismatch (-want +got):\n%s", diff)
+			}
+
+			roundTrip := mat3.MatrixMul(XYZToRGB(test.primaries, test.white), got)
+			if diff := cmp.Diff(mat3.Identity(), roundTrip, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+				t.Errorf("XYZToRGB() x RGBToXYZ() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChromaticityXYZ(t *testing.T) {
+	// The XYZ of D65 and D50 normalised to Y = 1, as used by ICC profiles. They are computed
+	// from the spectral distributions rather than the rounded chromaticities, hence the
+	// tolerance.
+	testData := []struct {
+		name  string
+		white Chromaticity
+		want  vec3.Vec3Impl
+	}{
+		{name: "D65", white: D65, want: vec3.Vec3Impl{X: 0.95047, Y: 1, Z: 1.08883}},
+		{name: "D50", white: D50, want: vec3.Vec3Impl{X: 0.96422, Y: 1, Z: 0.82521}},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.white.XYZ(), cmpopts.EquateApprox(0, 3e-4)); diff != "" {
+				t.Errorf("XYZ() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
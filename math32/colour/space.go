@@ -0,0 +1,118 @@
+package colour
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// Space is an RGB colour space.
+type Space struct {
+	Name      string
+	Primaries Primaries
+	White     Chromaticity
+	// Transfer encodes the linear values, or is nil if the space stores them as they are.
+	Transfer *TransferFunction
+}
+
+// Standard colour spaces.
+var (
+	// SRGB is sRGB with its piecewise transfer function.
+	SRGB = Space{Name: "sRGB", Primaries: Rec709, White: D65, Transfer: SRGBTransfer}
+	// LinearSRGB has the primaries of sRGB and Rec.709 and linear values.
+	LinearSRGB = Space{Name: "Linear sRGB", Primaries: Rec709, White: D65}
+	// LinearRec2020 has the primaries of Rec.2020 and linear values.
+	LinearRec2020 = Space{Name: "Linear Rec.2020", Primaries: Rec2020, White: D65}
+	// DCIP3 is DCI-P3 as used for digital cinema projection, with a 2.6 gamma.
+	DCIP3 = Space{Name: "DCI-P3", Primaries: P3, White: DCIWhite, Transfer: Gamma26Transfer}
+	// ACES2065 is ACES2065-1, the linear AP0 space used to archive and exchange images.
+	ACES2065 = Space{Name: "ACES2065-1", Primaries: AP0, White: ACESWhite}
+	// ACEScg is the linear AP1 space used for rendering and compositing.
+	ACEScg = Space{Name: "ACEScg", Primaries: AP1, White: ACESWhite}
+	// ACEScct is AP1 with a logarithmic encoding used for grading.
+	ACEScct = Space{Name: "ACEScct", Primaries: AP1, White: ACESWhite, Transfer: ACEScctTransfer}
+)
+
+// RGBToXYZ returns the matrix that converts linear RGB values in the space to CIE XYZ.
+func (s Space) RGBToXYZ() mat3.Mat3 {
+	return RGBToXYZ(s.Primaries, s.White)
+}
+
+// XYZToRGB returns the matrix that converts CIE XYZ to linear RGB values in the space.
+func (s Space) XYZToRGB() mat3.Mat3 {
+	return XYZToRGB(s.Primaries, s.White)
+}
+
+// Decode returns the linear values of the encoded rgb.
+func (s Space) Decode(rgb vec3.Vec3Impl) vec3.Vec3Impl {
+	if s.Transfer == nil {
+		return rgb
+	}
+	return vec3.Vec3Impl{
+		X: s.Transfer.Decode(rgb.X),
+		Y: s.Transfer.Decode(rgb.Y),
+		Z: s.Transfer.Decode(rgb.Z),
+	}
+}
+
+// Encode returns the encoded form of the linear rgb.
+func (s Space) Encode(rgb vec3.Vec3Impl) vec3.Vec3Impl {
+	if s.Transfer == nil {
+		return rgb
+	}
+	return vec3.Vec3Impl{
+		X: s.Transfer.Encode(rgb.X),
+		Y: s.Transfer.Encode(rgb.Y),
+		Z: s.Transfer.Encode(rgb.Z),
+	}
+}
+
+// ConversionMatrix returns the matrix that converts linear RGB values in src to linear RGB
+// values in dst, adapting between their white points with the supplied cone response
+// matrix.
+func ConversionMatrix(src, dst Space, cone mat3.Mat3) mat3.Mat3 {
+	adapt := ChromaticAdaptation(cone, src.White, dst.White)
+	return mat3.MatrixMul(dst.XYZToRGB(), mat3.MatrixMul(adapt, src.RGBToXYZ()))
+}
+
+// Converter converts RGB values from one colour space to another.
+type Converter struct {
+	src    Space
+	dst    Space
+	matrix mat3.Mat3
+}
+
+// NewConverter returns a Converter from src to dst that adapts between their white points
+// with the Bradford transform.
+func NewConverter(src, dst Space) *Converter {
+	return NewConverterWithAdaptation(src, dst, Bradford)
+}
+
+// NewConverterWithAdaptation returns a Converter from src to dst that adapts between their
+// white points with the supplied cone response matrix.
+func NewConverterWithAdaptation(src, dst Space, cone mat3.Mat3) *Converter {
+	return &Converter{
+		src:    src,
+		dst:    dst,
+		matrix: ConversionMatrix(src, dst, cone),
+	}
+}
+
+// Matrix returns the matrix applied to the linear values.
+func (c *Converter) Matrix() mat3.Mat3 {
+	return c.matrix
+}
+
+// Convert decodes rgb from the source space, converts it and encodes it in the
+// destination space.
+func (c *Converter) Convert(rgb vec3.Vec3Impl) vec3.Vec3Impl {
+	return c.dst.Encode(mat3.MatrixVectorMul(c.matrix, c.src.Decode(rgb)))
+}
+
+// ConvertSlice converts every element of src and stores the results in dst.
+// It panics if dst is shorter than src. dst and src may be the same slice.
+func (c *Converter) ConvertSlice(dst, src []vec3.Vec3Impl) {
+	dst = dst[:len(src)]
+	for i := range src {
+		dst[i] = c.Convert(src[i])
+	}
+}
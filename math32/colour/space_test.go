@@ -0,0 +1,169 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestConversionMatrix(t *testing.T) {
+	testData := []struct {
+		name string
+		src  Space
+		dst  Space
+		want mat3.Mat3
+	}{
+		{
+			// Academy TB-2014-004.
+			name: "ACES2065-1 to ACEScg",
+			src:  ACES2065,
+			dst:  ACEScg,
+			want: mat3.Mat3{
+				A11: 1.4514393161, A12: -0.2365107469, A13: -0.2149285693,
+				A21: -0.0765537734, A22: 1.1762296998, A23: -0.0996759264,
+				A31: 0.0083161484, A32: -0.0060324498, A33: 0.9977163014,
+			},
+		},
+		{
+			// Academy TB-2014-004.
+			name: "ACEScg to ACES2065-1",
+			src:  ACEScg,
+			dst:  ACES2065,
+			want: mat3.Mat3{
+				A11: 0.6954522414, A12: 0.1406786965, A13: 0.1638690622,
+				A21: 0.0447945634, A22: 0.8596711185, A23: 0.0955343182,
+				A31: -0.0055258826, A32: 0.0040252103, A33: 1.0015006723,
+			},
+		},
+		{
+			// The Bradford adapted matrix of the ACES and OpenColorIO configurations.
+			name: "Linear sRGB to ACEScg",
+			src:  LinearSRGB,
+			dst:  ACEScg,
+			want: mat3.Mat3{
+				A11: 0.6130974024, A12: 0.3395231462, A13: 0.0473794514,
+				A21: 0.0701937225, A22: 0.9163538791, A23: 0.0134523985,
+				A31: 0.0206155929, A32: 0.1095697729, A33: 0.8698146342,
+			},
+		},
+		{
+			// ITU-R BT.2087.
+			name: "Linear sRGB to Linear Rec.2020",
+			src:  LinearSRGB,
+			dst:  LinearRec2020,
+			want: mat3.Mat3{
+				A11: 0.6274, A12: 0.3293, A13: 0.0433,
+				A21: 0.0691, A22: 0.9195, A23: 0.0114,
+				A31: 0.0164, A32: 0.0880, A33: 0.8956,
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := ConversionMatrix(test.src, test.dst, Bradford)
+			if diff := cmp.Diff(test.want, got, cmpopts.EquateApprox(0, 1e-4)); diff != "" {
+				t.Errorf("ConversionMatrix() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConverterRoundTrip(t *testing.T) {
+	spaces := []Space{SRGB, LinearSRGB, LinearRec2020, DCIP3, ACES2065, ACEScg, ACEScct}
+	colours := []vec3.Vec3Impl{
+		{X: 0, Y: 0, Z: 0},
+		{X: 0.18, Y: 0.18, Z: 0.18},
+		{X: 1, Y: 1, Z: 1},
+		{X: 0.8, Y: 0.2, Z: 0.1},
+		{X: 0.05, Y: 0.5, Z: 0.9},
+	}
+
+	for _, src := range spaces {
+		for _, dst := range spaces {
+			t.Run(src.Name+" to "+dst.Name, func(t *testing.T) {
+				forward := NewConverter(src, dst)
+				backward := NewConverter(dst, src)
+				for _, c := range colours {
+					got := backward.Convert(forward.Convert(c))
+					// ACEScct encodes 0.9 as about 66, which limits the absolute precision
+					// of the other channels.
+					if diff := cmp.Diff(c, got, cmpopts.EquateApprox(0, 1e-4)); diff != "" {
+						t.Errorf("round trip of %v mismatch (-want +got):\n%s", c, diff)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestConverterWhite(t *testing.T) {
+	// White and grey stay neutral between spaces with different white points.
+	testData := []struct {
+		name string
+		src  Space
+		dst  Space
+		in   vec3.Vec3Impl
+		want vec3.Vec3Impl
+	}{
+		{
+			name: "sRGB white to ACEScg",
+			src:  SRGB,
+			dst:  ACEScg,
+			in:   vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+			want: vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+		},
+		{
+			name: "ACEScg mid grey to ACEScct",
+			src:  ACEScg,
+			dst:  ACEScct,
+			in:   vec3.Vec3Impl{X: 0.18, Y: 0.18, Z: 0.18},
+			want: vec3.Vec3Impl{X: 0.4135884, Y: 0.4135884, Z: 0.4135884},
+		},
+		{
+			name: "DCI-P3 white to Linear Rec.2020",
+			src:  DCIP3,
+			dst:  LinearRec2020,
+			in:   vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+			want: vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := NewConverter(test.src, test.dst).Convert(test.in)
+			if diff := cmp.Diff(test.want, got, cmpopts.EquateApprox(0, 1e-5)); diff != "" {
+				t.Errorf("Convert() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertSlice(t *testing.T) {
+	c := NewConverterWithAdaptation(SRGB, ACEScct, CAT02)
+	src := []vec3.Vec3Impl{{X: 0.1, Y: 0.2, Z: 0.3}, {X: 1, Y: 0.5, Z: 0}, {X: 0.9, Y: 0.9, Z: 0.9}}
+
+	want := make([]vec3.Vec3Impl, len(src))
+	for i := range src {
+		want[i] = c.Convert(src[i])
+	}
+
+	c.ConvertSlice(src, src)
+	if diff := cmp.Diff(want, src); diff != "" {
+		t.Errorf("ConvertSlice() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func BenchmarkConvert(b *testing.B) {
+	c := NewConverter(SRGB, ACEScg)
+	v := vec3.Vec3Impl{X: 0.25, Y: 0.5, Z: 0.75}
+
+	var result vec3.Vec3Impl
+	for i := 0; i < b.N; i++ {
+		result = c.Convert(v)
+	}
+	_ = result
+}
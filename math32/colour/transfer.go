@@ -0,0 +1,70 @@
+package colour
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+)
+
+// TransferFunction converts between linear values and the encoded values stored in an
+// image. A nil TransferFunction in a Space means that the space is linear.
+type TransferFunction struct {
+	// Encode converts a linear value to its encoded form.
+	Encode func(x float32) float32
+	// Decode converts an encoded value back to linear.
+	Decode func(x float32) float32
+}
+
+// SRGBTransfer is the piecewise sRGB transfer function of IEC 61966-2-1.
+var SRGBTransfer = &TransferFunction{
+	Encode: func(x float32) float32 {
+		if x <= 0.0031308 {
+			return 12.92 * x
+		}
+		return 1.055*math32.Pow(x, 1/2.4) - 0.055
+	},
+	Decode: func(x float32) float32 {
+		if x <= 0.04045 {
+			return x / 12.92
+		}
+		return math32.Pow((x+0.055)/1.055, 2.4)
+	},
+}
+
+// Gamma26Transfer is the pure 2.6 power law of DCI-P3 projection. Negative values are
+// mirrored around zero.
+var Gamma26Transfer = &TransferFunction{
+	Encode: func(x float32) float32 {
+		return math32.Copysign(math32.Pow(math32.Abs(x), 1/2.6), x)
+	},
+	Decode: func(x float32) float32 {
+		return math32.Copysign(math32.Pow(math32.Abs(x), 2.6), x)
+	},
+}
+
+// ACEScct constants from Academy specification S-2016-001.
+const (
+	acescctA         = 10.5402377416545
+	acescctB         = 0.0729055341958355
+	acescctLinBreak  = 0.0078125
+	acescctCctBreak  = 0.155251141552511
+	acescctHalfMax   = 65504
+	acescctLogOffset = 9.72
+	acescctLogScale  = 17.52
+)
+
+// ACEScctTransfer is the logarithmic encoding of ACEScct, with a linear toe below
+// 2^-7 so that it is defined for zero and negative values.
+var ACEScctTransfer = &TransferFunction{
+	Encode: func(x float32) float32 {
+		if x <= acescctLinBreak {
+			return acescctA*x + acescctB
+		}
+		return (math32.Log2(x) + acescctLogOffset) / acescctLogScale
+	},
+	Decode: func(x float32) float32 {
+		if x <= acescctCctBreak {
+			return (x - acescctB) / acescctA
+		}
+		// Values above the encoding of the largest half float decode to it.
+		return math32.Min(math32.Exp2(x*acescctLogScale-acescctLogOffset), acescctHalfMax)
+	},
+}
@@ -0,0 +1,49 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestTransferFunctions(t *testing.T) {
+	testData := []struct {
+		name     string
+		transfer *TransferFunction
+		linear   float32
+		encoded  float32
+	}{
+		{name: "sRGB black", transfer: SRGBTransfer, linear: 0, encoded: 0},
+		{name: "sRGB linear segment", transfer: SRGBTransfer, linear: 0.002, encoded: 0.02584},
+		{name: "sRGB mid grey", transfer: SRGBTransfer, linear: 0.18, encoded: 0.46135613},
+		{name: "sRGB white", transfer: SRGBTransfer, linear: 1, encoded: 1},
+		{name: "Gamma 2.6 half", transfer: Gamma26Transfer, linear: 0.16493848, encoded: 0.5},
+		{name: "Gamma 2.6 negative", transfer: Gamma26Transfer, linear: -0.16493848, encoded: -0.5},
+		{name: "Gamma 2.6 white", transfer: Gamma26Transfer, linear: 1, encoded: 1},
+		{name: "ACEScct black", transfer: ACEScctTransfer, linear: 0, encoded: 0.0729055341958355},
+		{name: "ACEScct break point", transfer: ACEScctTransfer, linear: 0.0078125, encoded: 0.155251141552511},
+		{name: "ACEScct mid grey", transfer: ACEScctTransfer, linear: 0.18, encoded: 0.4135884},
+		{name: "ACEScct white", transfer: ACEScctTransfer, linear: 1, encoded: 0.5547945},
+		{name: "ACEScct negative", transfer: ACEScctTransfer, linear: -0.01, encoded: -0.032496843},
+	}
+
+	approx := cmpopts.EquateApprox(0, 1e-6)
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.encoded, test.transfer.Encode(test.linear), approx); diff != "" {
+				t.Errorf("Encode() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.linear, test.transfer.Decode(test.encoded), approx); diff != "" {
+				t.Errorf("Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestACEScctDecodeClamps(t *testing.T) {
+	// Encoded values above the largest half float decode to it.
+	if got := ACEScctTransfer.Decode(2); got != 65504 {
+		t.Errorf("Decode(2) = %v, want 65504", got)
+	}
+}
@@ -70,6 +70,43 @@ func Exp(x float32) float32 {
 	return ldexp32(result, k)
 }
 
+// Exp2 returns 2**x, the base-2 exponential of x.
+//
+// Special cases are the same as Exp.
+func Exp2(x float32) float32 {
+	// Handle special cases
+	if IsNaN(x) {
+		return x
+	}
+
+	if IsInf(x, 1) {
+		return x // +Inf
+	}
+
+	if IsInf(x, -1) {
+		return 0 // 2^-Inf = 0
+	}
+
+	// Check for overflow/underflow
+	if x >= 128 {
+		return Inf(1)
+	}
+
+	if x < -150 {
+		return 0
+	}
+
+	// Range reduction: 2^x = 2^k * 2^f = 2^k * exp(f*ln(2))
+	// where k is the nearest integer to x, so |f| <= 1/2 and f is exact
+	k := int32(x + 0.5)
+	if x < 0 {
+		k = int32(x - 0.5)
+	}
+	f := x - float32(k)
+
+	return ldexp32(expKernel(f*Ln2), k)
+}
+
 // expKernel evaluates exp(r) for small r using polynomial approximation
 // Valid for |r| < ln(2)/2 ≈ 0.347
 func expKernel(r float32) float32 {
@@ -162,6 +162,42 @@ func TestExpAccuracy(t *testing.T) {
 	}
 }
 
+func TestExp2(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"NaN", NaN(), NaN()},
+		{"+Inf", Inf(1), Inf(1)},
+		{"-Inf", Inf(-1), 0},
+		{"overflow", 128, Inf(1)},
+		{"underflow", -151, 0},
+
+		// Exact powers of two
+		{"zero", 0, 1},
+		{"one", 1, 2},
+		{"ten", 10, 1024},
+		{"-1", -1, 0.5},
+		{"127", 127, 0x1p127},
+		{"-126", -126, 0x1p-126},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Exp2(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Exp2(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExp2ULP(t *testing.T) {
+	checkULP(t, "Exp2", Exp2, math.Exp2, uniformInputs(100000, -126, 128), 3)
+	checkULP(t, "Exp2", Exp2, math.Exp2, uniformInputs(100000, -1, 1), 3)
+}
+
 func TestExpMonotonicity(t *testing.T) {
 	// Exp should be strictly increasing
 	prev := Exp(-100)
@@ -260,6 +296,15 @@ func BenchmarkExpNegative(b *testing.B) {
 	_ = result
 }
 
+func BenchmarkExp2(b *testing.B) {
+	x := float32(5.5)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Exp2(x)
+	}
+	_ = result
+}
+
 func BenchmarkExpFloat64(b *testing.B) {
 	x := 5.0
 	var result float64
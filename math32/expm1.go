@@ -0,0 +1,38 @@
+package math32
+
+// Expm1 returns e**x - 1, the base-e exponential of x minus 1.
+// It is more accurate than Exp(x) - 1 when x is near zero.
+//
+// Special cases are:
+//
+//	Expm1(+Inf) = +Inf
+//	Expm1(-Inf) = -1
+//	Expm1(NaN) = NaN
+//
+// Very large values overflow to +Inf.
+func Expm1(x float32) float32 {
+	// Handle special cases
+	if x == 0 || IsNaN(x) {
+		return x // preserves sign of zero
+	}
+
+	if IsInf(x, -1) {
+		return -1
+	}
+
+	u := Exp(x)
+	if u == 1 {
+		// x is so small that e^x rounds to 1, and e^x - 1 ≈ x
+		return x
+	}
+
+	um1 := u - 1
+	if um1 == -1 || um1 == u {
+		// e^x is so small or so large that the -1 no longer matters
+		return um1
+	}
+
+	// Kahan's trick: the rounding error in u cancels out in (u-1)/log(u),
+	// so (u-1)·x/log(u) is accurate even though u-1 alone is not.
+	return um1 * x / Log(u)
+}
@@ -0,0 +1,50 @@
+package math32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExpm1(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"NaN", NaN(), NaN()},
+		{"+Inf", Inf(1), Inf(1)},
+		{"-Inf", Inf(-1), -1},
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+		{"overflow", 100, Inf(1)},
+		{"large negative", -100, -1},
+
+		// Tiny arguments return themselves
+		{"1e-10", 1e-10, 1e-10},
+		{"-1e-10", -1e-10, -1e-10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Expm1(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Expm1(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExpm1ULP(t *testing.T) {
+	checkULP(t, "Expm1", Expm1, math.Expm1, uniformInputs(100000, -20, 88), 5)
+	checkULP(t, "Expm1", Expm1, math.Expm1, uniformInputs(100000, -1, 1), 5)
+	checkULP(t, "Expm1", Expm1, math.Expm1, uniformInputs(100000, -1e-3, 1e-3), 5)
+}
+
+func BenchmarkExpm1(b *testing.B) {
+	x := float32(0.001)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Expm1(x)
+	}
+	_ = result
+}
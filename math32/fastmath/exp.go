@@ -0,0 +1,90 @@
+package fastmath
+
+import (
+	"math"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+)
+
+// FastExp2 returns an approximation of 2**x.
+// It returns 0 for x < -125 and +Inf for x >= 128, so results are never denormal.
+//
+// The maximum relative error is 3e-6.
+func FastExp2(x float32) float32 {
+	if x < -125 {
+		return 0
+	}
+
+	if x >= 128 {
+		return math32.Inf(1)
+	}
+
+	// 2^x = 2^k·2^f with k the nearest integer to x and f in [-1/2, 1/2]
+	k := (x + roundMagic) - roundMagic
+	f := x - k
+
+	// Minimax polynomial for 2^f on [-1/2, 1/2]
+	const (
+		c0 = 0.9999992614
+		c1 = 0.6931218147
+		c2 = 0.2402474483
+		c3 = 0.05591786076
+		c4 = 0.009570101967
+	)
+	p := c0 + f*(c1+f*(c2+f*(c3+f*c4)))
+
+	// Multiply by 2^k by adding k to the exponent field
+	return math.Float32frombits(math.Float32bits(p) + uint32(int32(k))<<23)
+}
+
+// FastExp returns an approximation of e**x.
+// It returns 0 for x < -86.6 and +Inf for x >= 88.73.
+//
+// The maximum relative error is 3e-6 + 6e-8·|x|: the second term comes from
+// rounding x·log2(e) and reaches 5e-6 at the ends of the range.
+func FastExp(x float32) float32 {
+	return FastExp2(x * math32.Log2E)
+}
+
+// FastLog2 returns an approximation of the binary logarithm of positive normal x.
+//
+// The maximum relative error is 8e-6.
+func FastLog2(x float32) float32 {
+	// Split x = 2^e·m with m in [sqrt(2)/2, sqrt(2)) without branches, by measuring
+	// the exponent from the bit pattern of sqrt(2)/2.
+	const sqrt2Over2Bits = 0x3f3504f3
+	bits := math.Float32bits(x)
+	e := int32(bits-sqrt2Over2Bits) >> 23
+	m := math.Float32frombits(bits - uint32(e)<<23)
+
+	// log2(1+t) = t·q(t) with a minimax polynomial q on [sqrt(2)/2-1, sqrt(2)-1]
+	const (
+		c0 = 1.442701618
+		c1 = -0.7212063895
+		c2 = 0.4798118529
+		c3 = -0.3664917099
+		c4 = 0.3181999455
+		c5 = -0.2061910775
+	)
+	t := m - 1
+	q := c0 + t*(c1+t*(c2+t*(c3+t*(c4+t*c5))))
+
+	return float32(e) + t*q
+}
+
+// FastLog returns an approximation of the natural logarithm of positive normal x.
+//
+// The maximum relative error is 8e-6.
+func FastLog(x float32) float32 {
+	return FastLog2(x) * math32.Ln2
+}
+
+// FastPow returns an approximation of x**y for positive normal x, computed as
+// FastExp2(y·FastLog2(x)).
+//
+// The error of the logarithm turns into relative error of the result, so the maximum
+// relative error is 6e-6·(1 + |y·log2(x)|). For example it is 1e-4 when the result is
+// within [2^-16, 2^16].
+func FastPow(x, y float32) float32 {
+	return FastExp2(y * FastLog2(x))
+}
@@ -0,0 +1,28 @@
+// Package fastmath provides approximate float32 math functions that trade accuracy for
+// speed, for shading code where a few parts per million do not matter.
+//
+// Every function documents its maximum relative error over a stated domain, and the
+// tests check those bounds against float64 math. Unlike the math32 package, special
+// cases are not handled unless documented: NaNs, infinities and arguments outside the
+// stated domain give unspecified results.
+package fastmath
+
+import "math"
+
+// roundMagic rounds a float32 to the nearest integer when added and subtracted again,
+// for |x| < 2^22.
+const roundMagic = 0x1.8p23
+
+// Rsqrt returns an approximation of 1/Sqrt(x) for positive normal x.
+//
+// The maximum relative error is 5e-6.
+func Rsqrt(x float32) float32 {
+	// Initial estimate from the exponent, then two Newton-Raphson iterations
+	// y = y·(3/2 - x/2·y²), each roughly squaring the relative error.
+	y := math.Float32frombits(0x5f375a86 - math.Float32bits(x)>>1)
+	halfX := 0.5 * x
+	y *= 1.5 - halfX*y*y
+	y *= 1.5 - halfX*y*y
+
+	return y
+}
@@ -0,0 +1,254 @@
+package fastmath
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+)
+
+const numSamples = 1000000
+
+func relativeError(got float32, want float64) float64 {
+	return math.Abs(float64(got)-want) / math.Abs(want)
+}
+
+func TestMaxRelativeError(t *testing.T) {
+	// Each input generator maps a uniform sample in [0, 1) to the documented domain.
+	logUniform := func(lo, hi float64) func(u float64) float32 {
+		return func(u float64) float32 {
+			return float32(math.Exp2(lo + (hi-lo)*u))
+		}
+	}
+	uniform := func(lo, hi float64) func(u float64) float32 {
+		return func(u float64) float32 {
+			return float32(lo + (hi-lo)*u)
+		}
+	}
+
+	testData := []struct {
+		name   string
+		fast   func(float32) float32
+		ref    func(float64) float64
+		input  func(u float64) float32
+		maxErr float64
+	}{
+		{
+			name:   "Rsqrt",
+			fast:   Rsqrt,
+			ref:    func(x float64) float64 { return 1 / math.Sqrt(x) },
+			input:  logUniform(-126, 128),
+			maxErr: 5e-6,
+		},
+		{name: "FastExp2", fast: FastExp2, ref: math.Exp2, input: uniform(-125, 128), maxErr: 3e-6},
+		{name: "FastExp2 near zero", fast: FastExp2, ref: math.Exp2, input: uniform(-1, 1), maxErr: 3e-6},
+		{name: "FastExp", fast: FastExp, ref: math.Exp, input: uniform(-86.6, 88.72), maxErr: 3e-6 + 6e-8*88.72},
+		{name: "FastExp near zero", fast: FastExp, ref: math.Exp, input: uniform(-1, 1), maxErr: 3e-6 + 6e-8},
+		{name: "FastLog2", fast: FastLog2, ref: math.Log2, input: logUniform(-126, 128), maxErr: 8e-6},
+		{name: "FastLog2 near one", fast: FastLog2, ref: math.Log2, input: uniform(0.5, 2), maxErr: 8e-6},
+		{name: "FastLog", fast: FastLog, ref: math.Log, input: logUniform(-126, 128), maxErr: 8e-6},
+		{name: "FastLog near one", fast: FastLog, ref: math.Log, input: uniform(0.5, 2), maxErr: 8e-6},
+		{name: "FastSin", fast: FastSin, ref: math.Sin, input: uniform(-2*math.Pi, 2*math.Pi), maxErr: 2e-6},
+		{name: "FastSin tiny", fast: FastSin, ref: math.Sin, input: logUniform(-126, 0), maxErr: 2e-6},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			random := rand.New(rand.NewSource(1))
+
+			var worst float64
+			var worstAt float32
+			for i := 0; i < numSamples; i++ {
+				x := test.input(random.Float64())
+				if err := relativeError(test.fast(x), test.ref(float64(x))); err > worst {
+					worst = err
+					worstAt = x
+				}
+			}
+
+			t.Logf("%s: maximum relative error %.3g at x=%v", test.name, worst, worstAt)
+			if worst > test.maxErr {
+				t.Errorf("%s(%v): relative error %.3g exceeds the documented %.3g", test.name, worstAt, worst, test.maxErr)
+			}
+		})
+	}
+}
+
+func TestFastSinLargeArguments(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < numSamples; i++ {
+		x := float32(16384*random.Float64() - 8192)
+		if i%2 == 0 {
+			// Land next to a zero of sin, where the relative error is largest.
+			x = float32(math.Pi * math.Round(float64(x)/math.Pi))
+		}
+
+		want := math.Sin(float64(x))
+		if err := math.Abs(float64(FastSin(x)) - want); err > 2e-6*math.Abs(want)+2e-11 {
+			t.Fatalf("FastSin(%v) = %v, want %v (error %.3g)", x, FastSin(x), want, err)
+		}
+	}
+}
+
+func TestFastPow(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	var worst float64
+	for i := 0; i < numSamples; i++ {
+		x := float32(math.Exp2(32*random.Float64() - 16))
+		y := float32(16*random.Float64() - 8)
+
+		want := math.Pow(float64(x), float64(y))
+		bound := 6e-6 * (1 + math.Abs(float64(y)*math.Log2(float64(x))))
+		// Results outside the normal range are flushed by FastExp2.
+		if want < 0x1p-125 || want > math.MaxFloat32 {
+			continue
+		}
+
+		err := relativeError(FastPow(x, y), want)
+		if err > bound {
+			t.Fatalf("FastPow(%v, %v) = %v, want %v (relative error %.3g, bound %.3g)", x, y, FastPow(x, y), want, err, bound)
+		}
+		worst = max(worst, err/bound)
+	}
+
+	t.Logf("FastPow: maximum error %.2f of the documented bound", worst)
+}
+
+func TestFastAtan2(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	var worst float64
+	for i := 0; i < numSamples; i++ {
+		y := float32(random.NormFloat64())
+		x := float32(random.NormFloat64())
+
+		err := relativeError(FastAtan2(y, x), math.Atan2(float64(y), float64(x)))
+		if err > 5e-6 {
+			t.Fatalf("FastAtan2(%v, %v) = %v, want %v (relative error %.3g)", y, x, FastAtan2(y, x), math.Atan2(float64(y), float64(x)), err)
+		}
+		worst = max(worst, err)
+	}
+
+	t.Logf("FastAtan2: maximum relative error %.3g", worst)
+
+	// Quadrants and axes
+	testData := []struct {
+		name string
+		y, x float32
+		want float64
+	}{
+		{name: "positive x axis", y: 0, x: 1, want: 0},
+		{name: "positive y axis", y: 1, x: 0, want: math.Pi / 2},
+		{name: "negative x axis", y: 0, x: -1, want: math.Pi},
+		{name: "negative y axis", y: -1, x: 0, want: -math.Pi / 2},
+		{name: "second quadrant", y: 1, x: -1, want: 3 * math.Pi / 4},
+		{name: "third quadrant", y: -1, x: -1, want: -3 * math.Pi / 4},
+		{name: "origin", y: 0, x: 0, want: 0},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if got := FastAtan2(test.y, test.x); math.Abs(float64(got)-test.want) > 5e-6*math.Abs(test.want) {
+				t.Errorf("FastAtan2(%v, %v) = %v, want %v", test.y, test.x, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFastExp2Limits(t *testing.T) {
+	testData := []struct {
+		name string
+		x    float32
+		want float32
+	}{
+		{name: "underflow", x: -126, want: 0},
+		{name: "overflow", x: 128, want: math32.Inf(1)},
+		{name: "largest", x: 127.99, want: float32(math.Exp2(127.99))},
+		{name: "smallest", x: -125, want: 0x1p-125},
+		{name: "integer", x: 10, want: 1024},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := FastExp2(test.x)
+			if math32.IsInf(test.want, 0) || test.want == 0 {
+				if got != test.want {
+					t.Errorf("FastExp2(%v) = %v, want %v", test.x, got, test.want)
+				}
+				return
+			}
+			if err := relativeError(got, float64(test.want)); err > 3e-6 {
+				t.Errorf("FastExp2(%v) = %v, want %v", test.x, got, test.want)
+			}
+		})
+	}
+}
+
+// benchmarkInputs returns inputs for the benchmarks in [lo, hi).
+func benchmarkInputs(lo, hi float32) []float32 {
+	random := rand.New(rand.NewSource(1))
+	xs := make([]float32, 1024)
+	for i := range xs {
+		xs[i] = lo + (hi-lo)*random.Float32()
+	}
+	return xs
+}
+
+func benchmarkPair(b *testing.B, fast, accurate func(float32) float32, lo, hi float32) {
+	xs := benchmarkInputs(lo, hi)
+	var sink float32
+
+	b.Run("Fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink += fast(xs[i&1023])
+		}
+	})
+
+	b.Run("Accurate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sink += accurate(xs[i&1023])
+		}
+	})
+
+	_ = sink
+}
+
+func BenchmarkRsqrt(b *testing.B) {
+	benchmarkPair(b, Rsqrt, func(x float32) float32 { return 1 / math32.Sqrt(x) }, 0.01, 100)
+}
+
+func BenchmarkFastExp2(b *testing.B) {
+	benchmarkPair(b, FastExp2, math32.Exp2, -20, 20)
+}
+
+func BenchmarkFastExp(b *testing.B) {
+	benchmarkPair(b, FastExp, math32.Exp, -20, 20)
+}
+
+func BenchmarkFastLog2(b *testing.B) {
+	benchmarkPair(b, FastLog2, math32.Log2, 0.01, 100)
+}
+
+func BenchmarkFastLog(b *testing.B) {
+	benchmarkPair(b, FastLog, math32.Log, 0.01, 100)
+}
+
+func BenchmarkFastSin(b *testing.B) {
+	benchmarkPair(b, FastSin, math32.Sin, -10, 10)
+}
+
+func BenchmarkFastPow(b *testing.B) {
+	benchmarkPair(b,
+		func(x float32) float32 { return FastPow(x, 2.4) },
+		func(x float32) float32 { return math32.Pow(x, 2.4) },
+		0.01, 1)
+}
+
+func BenchmarkFastAtan2(b *testing.B) {
+	benchmarkPair(b,
+		func(x float32) float32 { return FastAtan2(x, 0.7) },
+		func(x float32) float32 { return math32.Atan2(x, 0.7) },
+		-10, 10)
+}
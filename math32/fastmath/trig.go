@@ -0,0 +1,79 @@
+package fastmath
+
+import (
+	"math"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+)
+
+// FastSin returns an approximation of the sine of x, for |x| <= 8192.
+//
+// The maximum relative error is 2e-6 for |x| <= 2π, including close to the zeros of sin.
+// Beyond that the error is below 2e-6·|sin(x)| + 2e-11, so the relative error only grows
+// for results within about 1e-5 of zero.
+func FastSin(x float32) float32 {
+	// Reduce to r = x - k·π in [-π/2, π/2] with π split in three parts. The first two
+	// have few enough bits that k·pi1 and k·pi2 are exact for |k| < 2^12, so r keeps its
+	// relative accuracy even when it is tiny.
+	const (
+		invPi = 1 / math32.Pi
+		pi1   = 3.140625               // 201/64
+		pi2   = 9.67502593994140625e-4 // 2029/2^21
+		pi3   = 1.509957990978376e-7   // π - pi1 - pi2
+	)
+	k := (x*invPi + roundMagic) - roundMagic
+	r := ((x - k*pi1) - k*pi2) - k*pi3
+
+	// sin(r) = r·q(r²) with a minimax polynomial q on [0, (π/2)²]
+	const (
+		c0 = 0.9999990609
+		c1 = -0.1666555409
+		c2 = 0.008311899807
+		c3 = -0.0001848814029
+	)
+	s := r * r
+	result := r * (c0 + s*(c1+s*(c2+s*c3)))
+
+	// sin(x) = (-1)^k·sin(r)
+	return math.Float32frombits(math.Float32bits(result) ^ uint32(int32(k))<<31)
+}
+
+// FastAtan2 returns an approximation of the arc tangent of y/x, using the signs of the
+// two to determine the quadrant of the return value. FastAtan2(0, 0) returns 0.
+//
+// The maximum relative error is 5e-6.
+func FastAtan2(y, x float32) float32 {
+	ax, ay := math32.Abs(x), math32.Abs(y)
+
+	// Reduce to an argument in [0, 1] using atan(z) = π/2 - atan(1/z)
+	num, den := ay, ax
+	swap := ay > ax
+	if swap {
+		num, den = ax, ay
+	}
+	if den == 0 {
+		return 0
+	}
+	z := num / den
+
+	// atan(z) = z·q(z²) with a minimax polynomial q on [0, 1]
+	const (
+		c0 = 0.9999956297
+		c1 = -0.3329945978
+		c2 = 0.1956359294
+		c3 = -0.1212390792
+		c4 = 0.05747731997
+		c5 = -0.01348047111
+	)
+	s := z * z
+	result := z * (c0 + s*(c1+s*(c2+s*(c3+s*(c4+s*c5)))))
+
+	if swap {
+		result = math32.Pi/2 - result
+	}
+	if x < 0 {
+		result = math32.Pi - result
+	}
+
+	return math32.Copysign(result, y)
+}
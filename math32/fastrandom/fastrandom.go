@@ -1,14 +1,19 @@
+// Package fastrandom provides fast, non-cryptographic pseudo-random number generators.
 package fastrandom
 
 import (
 	"math/rand"
 	"time"
+
+	"github.com/flynn-nrg/go-vfx/math32/vec2"
 )
 
+// XorShift implements Vigna's xorshift128+ generator.
 type XorShift struct {
 	s [2]uint64
 }
 
+// New returns a new XorShift generator. A zero seed selects a time based seed.
 func New(seed uint32) *XorShift {
 	xs := &XorShift{}
 	if seed == 0 {
@@ -27,6 +32,7 @@ func New(seed uint32) *XorShift {
 	return xs
 }
 
+// NewWithDefaults returns a new XorShift generator with a random seed.
 func NewWithDefaults() *XorShift {
 	return New(rand.Uint32())
 }
@@ -47,6 +53,31 @@ func (xs *XorShift) nextUint64() uint64 {
 	return result
 }
 
+// Uint32 returns the next pseudo-random 32-bit value.
+func (xs *XorShift) Uint32() uint32 {
+	return uint32(xs.nextUint64() >> 32)
+}
+
+// Uint64 returns the next pseudo-random 64-bit value.
+func (xs *XorShift) Uint64() uint64 {
+	return xs.nextUint64()
+}
+
+// Intn returns a uniformly distributed value in [0, n). It panics if n <= 0.
+func (xs *XorShift) Intn(n int) int {
+	return intn(xs.nextUint64, n)
+}
+
+// Float64 returns a uniformly distributed value in [0, 1).
+func (xs *XorShift) Float64() float64 {
+	return float64FromUint64(xs.nextUint64())
+}
+
+// Float32Range returns a uniformly distributed value in [min, max).
+func (xs *XorShift) Float32Range(min, max float32) float32 {
+	return float32Range(xs.Float32(), min, max)
+}
+
 // Float32 generates the next pseudo-random number in the sequence
 // and returns it as a float32 within the range [0, 1).
 func (xs *XorShift) Float32() float32 {
@@ -54,3 +85,17 @@ func (xs *XorShift) Float32() float32 {
 
 	return float32(val>>40) / 16777216.0
 }
+
+// StartPixelSample is a no-op that lets XorShift be used as a sampler.Sampler.
+// White noise has no per-pixel structure, so samples simply continue the sequence.
+func (xs *XorShift) StartPixelSample(x, y int, index int) {}
+
+// Get1D returns the next pseudo-random number in [0, 1).
+func (xs *XorShift) Get1D() float32 {
+	return xs.Float32()
+}
+
+// Get2D returns the next two pseudo-random numbers in [0, 1)^2.
+func (xs *XorShift) Get2D() vec2.Vec2Impl {
+	return vec2.Vec2Impl{X: xs.Float32(), Y: xs.Float32()}
+}
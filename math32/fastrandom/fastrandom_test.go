@@ -0,0 +1,200 @@
+package fastrandom
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	_ Source = (*XorShift)(nil)
+	_ Source = (*PCG32)(nil)
+	_ Source = (*Xoshiro256)(nil)
+	_ Source = (*SplitMix64)(nil)
+)
+
+func testSources() []struct {
+	name   string
+	source Source
+} {
+	return []struct {
+		name   string
+		source Source
+	}{
+		{name: "XorShift", source: New(42)},
+		{name: "PCG32", source: NewPCG32(42, 54)},
+		{name: "Xoshiro256", source: NewXoshiro256(42)},
+		{name: "SplitMix64", source: NewSplitMix64(42)},
+	}
+}
+
+func TestKnownAnswers(t *testing.T) {
+	t.Run("SplitMix64", func(t *testing.T) {
+		sm := NewSplitMix64(1234567)
+		want := []uint64{6457827717110365317, 3203168211198807973, 9817491932198370423, 4593380528125082431, 16408922859458223821}
+		got := make([]uint64, len(want))
+		for i := range got {
+			got[i] = sm.Uint64()
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Uint64() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("PCG32", func(t *testing.T) {
+		p := NewPCG32(42, 54)
+		want := []uint32{0xa15c02b7, 0x7b47f409, 0xba1d3330, 0x83d2f293, 0xbfa4784b, 0xcbed606e}
+		got := make([]uint32, len(want))
+		for i := range got {
+			got[i] = p.Uint32()
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Uint32() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Xoshiro256", func(t *testing.T) {
+		x := NewXoshiro256(42)
+		want := []uint64{0x15780b2e0c2ec716, 0x6104d9866d113a7e, 0xae17533239e499a1, 0xecb8ad4703b360a1, 0xfde6dc7fe2ec5e64}
+		got := make([]uint64, len(want))
+		for i := range got {
+			got[i] = x.Uint64()
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Uint64() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestFloatRanges(t *testing.T) {
+	for _, test := range testSources() {
+		t.Run(test.name, func(t *testing.T) {
+			for i := 0; i < 100000; i++ {
+				if f := test.source.Float32(); f < 0 || f >= 1 {
+					t.Fatalf("Float32() = %v, want a value in [0, 1)", f)
+				}
+				if f := test.source.Float64(); f < 0 || f >= 1 {
+					t.Fatalf("Float64() = %v, want a value in [0, 1)", f)
+				}
+				if f := test.source.Float32Range(-2, 3); f < -2 || f >= 3 {
+					t.Fatalf("Float32Range(-2, 3) = %v, want a value in [-2, 3)", f)
+				}
+			}
+		})
+	}
+}
+
+func TestIntn(t *testing.T) {
+	const (
+		n       = 10
+		samples = 100000
+	)
+
+	for _, test := range testSources() {
+		t.Run(test.name, func(t *testing.T) {
+			var counts [n]int
+			for i := 0; i < samples; i++ {
+				v := test.source.Intn(n)
+				if v < 0 || v >= n {
+					t.Fatalf("Intn(%d) = %d", n, v)
+				}
+				counts[v]++
+			}
+
+			// Chi-squared test with 9 degrees of freedom at the 0.1% significance level.
+			expected := float64(samples) / n
+			chi2 := 0.0
+			for _, c := range counts {
+				d := float64(c) - expected
+				chi2 += d * d / expected
+			}
+			if chi2 > 27.88 {
+				t.Errorf("Intn(%d) counts %v fail the chi-squared test (%v)", n, counts, chi2)
+			}
+
+			if v := test.source.Intn(1); v != 0 {
+				t.Errorf("Intn(1) = %d, want 0", v)
+			}
+		})
+	}
+}
+
+func TestIntnPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Intn(0) did not panic")
+		}
+	}()
+	NewPCG32(1, 1).Intn(0)
+}
+
+func TestPCG32Streams(t *testing.T) {
+	a := NewPCG32(42, 1)
+	b := NewPCG32(42, 2)
+	same := 0
+	for i := 0; i < 1000; i++ {
+		if a.Uint32() == b.Uint32() {
+			same++
+		}
+	}
+	if same > 1 {
+		t.Errorf("streams 1 and 2 produced %d identical values out of 1000", same)
+	}
+}
+
+func TestXoshiro256Jump(t *testing.T) {
+	for _, jump := range []struct {
+		name string
+		fn   func(x *Xoshiro256)
+	}{
+		{name: "Jump", fn: (*Xoshiro256).Jump},
+		{name: "LongJump", fn: (*Xoshiro256).LongJump},
+	} {
+		t.Run(jump.name, func(t *testing.T) {
+			// A jump is a polynomial in the state transition, so it must commute with a single step.
+			a := NewXoshiro256(7)
+			b := NewXoshiro256(7)
+			jump.fn(a)
+			a.Uint64()
+			b.Uint64()
+			jump.fn(b)
+			if a.s != b.s {
+				t.Fatalf("%s does not commute with a step: %x != %x", jump.name, a.s, b.s)
+			}
+
+			// And it must move the generator somewhere else.
+			c := NewXoshiro256(7)
+			d := NewXoshiro256(7)
+			jump.fn(d)
+			for i := 0; i < 1000; i++ {
+				if c.Uint64() == d.Uint64() {
+					t.Fatalf("%s: value %d matches the original stream", jump.name, i)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUint64(b *testing.B) {
+	for _, test := range testSources() {
+		b.Run(test.name, func(b *testing.B) {
+			var sum uint64
+			for i := 0; i < b.N; i++ {
+				sum += test.source.Uint64()
+			}
+			_ = sum
+		})
+	}
+}
+
+func BenchmarkFloat32(b *testing.B) {
+	for _, test := range testSources() {
+		b.Run(test.name, func(b *testing.B) {
+			var sum float32
+			for i := 0; i < b.N; i++ {
+				sum += test.source.Float32()
+			}
+			_ = sum
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package fastrandom
+
+import "math/bits"
+
+const pcgMultiplier = 6364136223846793005
+
+// PCG32 implements O'Neill's PCG-XSH-RR generator with 64-bit state and 32-bit output.
+// Generators seeded with the same seed but different streams produce distinct sequences,
+// which makes it easy to give every render tile its own stream.
+type PCG32 struct {
+	state uint64
+	inc   uint64
+}
+
+// NewPCG32 returns a new PCG32 generator for the given seed and stream.
+// Only the lower 63 bits of stream are significant.
+func NewPCG32(seed uint64, stream uint64) *PCG32 {
+	p := &PCG32{inc: stream<<1 | 1}
+	p.Uint32()
+	p.state += seed
+	p.Uint32()
+
+	return p
+}
+
+// Uint32 returns the next pseudo-random 32-bit value.
+func (p *PCG32) Uint32() uint32 {
+	old := p.state
+	p.state = old*pcgMultiplier + p.inc
+	xorShifted := uint32(((old >> 18) ^ old) >> 27)
+	rot := int(old >> 59)
+
+	return bits.RotateLeft32(xorShifted, -rot)
+}
+
+// Uint64 returns the next pseudo-random 64-bit value built from two 32-bit outputs.
+func (p *PCG32) Uint64() uint64 {
+	hi := uint64(p.Uint32())
+
+	return hi<<32 | uint64(p.Uint32())
+}
+
+// Intn returns a uniformly distributed value in [0, n). It panics if n <= 0.
+func (p *PCG32) Intn(n int) int {
+	return intn(p.Uint64, n)
+}
+
+// Float32 returns a uniformly distributed value in [0, 1).
+// It consumes a single 32-bit output.
+func (p *PCG32) Float32() float32 {
+	return float32(p.Uint32()>>8) * 0x1p-24
+}
+
+// Float64 returns a uniformly distributed value in [0, 1).
+func (p *PCG32) Float64() float64 {
+	return float64FromUint64(p.Uint64())
+}
+
+// Float32Range returns a uniformly distributed value in [min, max).
+func (p *PCG32) Float32Range(min, max float32) float32 {
+	return float32Range(p.Float32(), min, max)
+}
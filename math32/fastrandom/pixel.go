@@ -0,0 +1,47 @@
+package fastrandom
+
+// PixelSeed hashes the pixel coordinates, sample index and frame seed into a 64-bit seed.
+// The result only depends on its arguments, so generators seeded with it produce the same
+// sequence for a pixel sample regardless of which goroutine renders it or in which order.
+func PixelSeed(x, y, sampleIndex int, frameSeed uint64) uint64 {
+	sm := NewSplitMix64(frameSeed)
+	h := sm.Uint64()
+	sm.s = h ^ (uint64(uint32(x)) | uint64(uint32(y))<<32)
+	h = sm.Uint64()
+	sm.s = h ^ uint64(sampleIndex)
+
+	return sm.Uint64()
+}
+
+// PCGHash is the stateless PCG-based hash from Jarzynski and Olano,
+// "Hash Functions for GPU Rendering", 2020. It is a good choice to derive a single
+// random value from a counter.
+func PCGHash(v uint32) uint32 {
+	state := v*747796405 + 2891336453
+	word := ((state >> ((state >> 28) + 4)) ^ state) * 277803737
+
+	return (word >> 22) ^ word
+}
+
+// NewForPixel returns a new XorShift generator seeded deterministically from the pixel
+// coordinates, sample index and frame seed.
+func NewForPixel(x, y, sampleIndex int, frameSeed uint64) *XorShift {
+	sm := NewSplitMix64(PixelSeed(x, y, sampleIndex, frameSeed))
+	xs := &XorShift{}
+	xs.s[0] = sm.Uint64()
+	xs.s[1] = sm.Uint64()
+
+	if xs.s[0] == 0 && xs.s[1] == 0 {
+		xs.s[0] = 1
+	}
+
+	return xs
+}
+
+// NewPCG32ForPixel returns a new PCG32 generator seeded deterministically from the pixel
+// coordinates, sample index and frame seed. Every pixel uses its own stream.
+func NewPCG32ForPixel(x, y, sampleIndex int, frameSeed uint64) *PCG32 {
+	stream := uint64(uint32(x)) | uint64(uint32(y))<<32
+
+	return NewPCG32(PixelSeed(x, y, sampleIndex, frameSeed), stream)
+}
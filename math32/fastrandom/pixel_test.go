@@ -0,0 +1,124 @@
+package fastrandom
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	imageWidth      = 64
+	imageHeight     = 48
+	tileSize        = 8
+	samplesPerPixel = 4
+)
+
+// renderImage simulates a tiled render where every pixel sample draws a few random numbers.
+// Tiles are handed out to the workers through a channel, so the order in which pixels
+// are rendered depends on the number of workers and on scheduling.
+func renderImage(workers int, newSource func(x, y, sample int) Source) []float32 {
+	image := make([]float32, imageWidth*imageHeight)
+	tiles := make(chan [2]int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tile := range tiles {
+				for y := tile[1]; y < tile[1]+tileSize; y++ {
+					for x := tile[0]; x < tile[0]+tileSize; x++ {
+						var sum float32
+						for s := 0; s < samplesPerPixel; s++ {
+							src := newSource(x, y, s)
+							for i := 0; i < 8; i++ {
+								sum += src.Float32()
+							}
+						}
+						image[y*imageWidth+x] = sum
+					}
+				}
+			}
+		}()
+	}
+
+	for ty := 0; ty < imageHeight; ty += tileSize {
+		for tx := 0; tx < imageWidth; tx += tileSize {
+			tiles <- [2]int{tx, ty}
+		}
+	}
+	close(tiles)
+	wg.Wait()
+
+	return image
+}
+
+func TestPixelSeedingIsReproducible(t *testing.T) {
+	const frameSeed = 20251016
+
+	testData := []struct {
+		name      string
+		newSource func(x, y, sample int) Source
+	}{
+		{
+			name: "XorShift",
+			newSource: func(x, y, sample int) Source {
+				return NewForPixel(x, y, sample, frameSeed)
+			},
+		},
+		{
+			name: "PCG32",
+			newSource: func(x, y, sample int) Source {
+				return NewPCG32ForPixel(x, y, sample, frameSeed)
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			want := renderImage(1, test.newSource)
+			for _, workers := range []int{2, 5, 16} {
+				if diff := cmp.Diff(want, renderImage(workers, test.newSource)); diff != "" {
+					t.Errorf("render with %d workers differs from the serial one (-want +got):\n%s", workers, diff)
+				}
+			}
+
+			// Neighbouring pixels must not repeat each other.
+			seen := make(map[float32]int)
+			for _, v := range want {
+				seen[v]++
+			}
+			if len(seen) < len(want)*99/100 {
+				t.Errorf("only %d distinct pixel values out of %d", len(seen), len(want))
+			}
+		})
+	}
+}
+
+func TestPixelSeed(t *testing.T) {
+	base := PixelSeed(10, 20, 3, 99)
+	if got := PixelSeed(10, 20, 3, 99); got != base {
+		t.Errorf("PixelSeed() is not deterministic: %x != %x", got, base)
+	}
+
+	for _, other := range []uint64{
+		PixelSeed(11, 20, 3, 99),
+		PixelSeed(10, 21, 3, 99),
+		PixelSeed(20, 10, 3, 99),
+		PixelSeed(10, 20, 4, 99),
+		PixelSeed(10, 20, 3, 100),
+	} {
+		if other == base {
+			t.Errorf("PixelSeed() collision for different arguments: %x", base)
+		}
+	}
+}
+
+func TestPCGHash(t *testing.T) {
+	want := []uint32{0x07bb2fe2, 0xa8beea3c, 0x48f432ff}
+	got := []uint32{PCGHash(0), PCGHash(1), PCGHash(42)}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PCGHash() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,54 @@
+package fastrandom
+
+import "math/bits"
+
+// Source is the interface implemented by all the pseudo-random number generators in this package.
+// Generators are not safe for concurrent use; give each goroutine its own stream instead.
+type Source interface {
+	// Uint32 returns the next pseudo-random 32-bit value.
+	Uint32() uint32
+	// Uint64 returns the next pseudo-random 64-bit value.
+	Uint64() uint64
+	// Intn returns a uniformly distributed value in [0, n). It panics if n <= 0.
+	Intn(n int) int
+	// Float32 returns a uniformly distributed value in [0, 1).
+	Float32() float32
+	// Float64 returns a uniformly distributed value in [0, 1).
+	Float64() float64
+	// Float32Range returns a uniformly distributed value in [min, max).
+	Float32Range(min, max float32) float32
+}
+
+// float32FromUint64 maps the top 24 bits of v to a float32 in [0, 1).
+func float32FromUint64(v uint64) float32 {
+	return float32(v>>40) * 0x1p-24
+}
+
+// float64FromUint64 maps the top 53 bits of v to a float64 in [0, 1).
+func float64FromUint64(v uint64) float64 {
+	return float64(v>>11) * 0x1p-53
+}
+
+// float32Range maps u in [0, 1) to [min, max).
+func float32Range(u float32, min, max float32) float32 {
+	return min + (max-min)*u
+}
+
+// intn returns an unbiased value in [0, n) using Lemire's multiply-and-reject method,
+// "Fast Random Integer Generation in an Interval", 2019.
+func intn(next func() uint64, n int) int {
+	if n <= 0 {
+		panic("fastrandom: invalid argument to Intn")
+	}
+
+	bound := uint64(n)
+	hi, lo := bits.Mul64(next(), bound)
+	if lo < bound {
+		threshold := -bound % bound
+		for lo < threshold {
+			hi, lo = bits.Mul64(next(), bound)
+		}
+	}
+
+	return int(hi)
+}
@@ -0,0 +1,48 @@
+package fastrandom
+
+// SplitMix64 implements Vigna's SplitMix64 generator.
+// It has a tiny state and passes BigCrush, and it is mainly used to expand a single seed
+// into the larger states of the other generators.
+type SplitMix64 struct {
+	s uint64
+}
+
+// NewSplitMix64 returns a new SplitMix64 generator. Every seed, including zero, is valid.
+func NewSplitMix64(seed uint64) *SplitMix64 {
+	return &SplitMix64{s: seed}
+}
+
+// Uint64 returns the next pseudo-random 64-bit value.
+func (sm *SplitMix64) Uint64() uint64 {
+	sm.s += 0x9e3779b97f4a7c15
+	z := sm.s
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+
+	return z ^ (z >> 31)
+}
+
+// Uint32 returns the next pseudo-random 32-bit value.
+func (sm *SplitMix64) Uint32() uint32 {
+	return uint32(sm.Uint64() >> 32)
+}
+
+// Intn returns a uniformly distributed value in [0, n). It panics if n <= 0.
+func (sm *SplitMix64) Intn(n int) int {
+	return intn(sm.Uint64, n)
+}
+
+// Float32 returns a uniformly distributed value in [0, 1).
+func (sm *SplitMix64) Float32() float32 {
+	return float32FromUint64(sm.Uint64())
+}
+
+// Float64 returns a uniformly distributed value in [0, 1).
+func (sm *SplitMix64) Float64() float64 {
+	return float64FromUint64(sm.Uint64())
+}
+
+// Float32Range returns a uniformly distributed value in [min, max).
+func (sm *SplitMix64) Float32Range(min, max float32) float32 {
+	return float32Range(sm.Float32(), min, max)
+}
@@ -0,0 +1,179 @@
+package fastrandom
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Tags identifying the generator in the binary encoding of its state.
+const (
+	tagXorShift   = 'X'
+	tagPCG32      = 'P'
+	tagXoshiro256 = 'O'
+	tagSplitMix64 = 'S'
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*XorShift)(nil)
+	_ encoding.BinaryUnmarshaler = (*XorShift)(nil)
+	_ encoding.BinaryMarshaler   = (*PCG32)(nil)
+	_ encoding.BinaryUnmarshaler = (*PCG32)(nil)
+	_ encoding.BinaryMarshaler   = (*Xoshiro256)(nil)
+	_ encoding.BinaryUnmarshaler = (*Xoshiro256)(nil)
+	_ encoding.BinaryMarshaler   = (*SplitMix64)(nil)
+	_ encoding.BinaryUnmarshaler = (*SplitMix64)(nil)
+)
+
+// State returns the internal state of the generator.
+func (xs *XorShift) State() [2]uint64 {
+	return xs.s
+}
+
+// SetState restores a state previously returned by State.
+// It returns an error for the all-zero state, which the generator cannot leave.
+func (xs *XorShift) SetState(s [2]uint64) error {
+	if s[0] == 0 && s[1] == 0 {
+		return errors.New("fastrandom: invalid XorShift state: all zero")
+	}
+
+	xs.s = s
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (xs *XorShift) MarshalBinary() ([]byte, error) {
+	return marshalWords(tagXorShift, xs.s[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (xs *XorShift) UnmarshalBinary(data []byte) error {
+	var s [2]uint64
+	if err := unmarshalWords(data, tagXorShift, s[:]); err != nil {
+		return err
+	}
+
+	return xs.SetState(s)
+}
+
+// State returns the internal state of the generator as the LCG state and increment.
+func (p *PCG32) State() [2]uint64 {
+	return [2]uint64{p.state, p.inc}
+}
+
+// SetState restores a state previously returned by State.
+// It returns an error if the increment is even, since it must be odd.
+func (p *PCG32) SetState(s [2]uint64) error {
+	if s[1]&1 == 0 {
+		return fmt.Errorf("fastrandom: invalid PCG32 state: even increment %#x", s[1])
+	}
+
+	p.state = s[0]
+	p.inc = s[1]
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (p *PCG32) MarshalBinary() ([]byte, error) {
+	return marshalWords(tagPCG32, p.state, p.inc), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (p *PCG32) UnmarshalBinary(data []byte) error {
+	var s [2]uint64
+	if err := unmarshalWords(data, tagPCG32, s[:]); err != nil {
+		return err
+	}
+
+	return p.SetState(s)
+}
+
+// State returns the internal state of the generator.
+func (x *Xoshiro256) State() [4]uint64 {
+	return x.s
+}
+
+// SetState restores a state previously returned by State.
+// It returns an error for the all-zero state, which the generator cannot leave.
+func (x *Xoshiro256) SetState(s [4]uint64) error {
+	if s[0] == 0 && s[1] == 0 && s[2] == 0 && s[3] == 0 {
+		return errors.New("fastrandom: invalid Xoshiro256 state: all zero")
+	}
+
+	x.s = s
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (x *Xoshiro256) MarshalBinary() ([]byte, error) {
+	return marshalWords(tagXoshiro256, x.s[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (x *Xoshiro256) UnmarshalBinary(data []byte) error {
+	var s [4]uint64
+	if err := unmarshalWords(data, tagXoshiro256, s[:]); err != nil {
+		return err
+	}
+
+	return x.SetState(s)
+}
+
+// State returns the internal state of the generator.
+func (sm *SplitMix64) State() uint64 {
+	return sm.s
+}
+
+// SetState restores a state previously returned by State. Every state is valid.
+func (sm *SplitMix64) SetState(s uint64) {
+	sm.s = s
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (sm *SplitMix64) MarshalBinary() ([]byte, error) {
+	return marshalWords(tagSplitMix64, sm.s), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (sm *SplitMix64) UnmarshalBinary(data []byte) error {
+	var s [1]uint64
+	if err := unmarshalWords(data, tagSplitMix64, s[:]); err != nil {
+		return err
+	}
+
+	sm.s = s[0]
+
+	return nil
+}
+
+// marshalWords encodes a generator tag followed by its state words in big-endian order.
+func marshalWords(tag byte, words ...uint64) []byte {
+	data := make([]byte, 1, 1+8*len(words))
+	data[0] = tag
+	for _, w := range words {
+		data = binary.BigEndian.AppendUint64(data, w)
+	}
+
+	return data
+}
+
+// unmarshalWords decodes the state words written by marshalWords into words.
+func unmarshalWords(data []byte, tag byte, words []uint64) error {
+	if len(data) != 1+8*len(words) {
+		return fmt.Errorf("fastrandom: invalid state length: got %d bytes, want %d", len(data), 1+8*len(words))
+	}
+
+	if data[0] != tag {
+		return fmt.Errorf("fastrandom: invalid state tag: got %q, want %q", data[0], tag)
+	}
+
+	for i := range words {
+		words[i] = binary.BigEndian.Uint64(data[1+8*i:])
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package fastrandom
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type checkpointable interface {
+	Source
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+func draw(src Source, n int) []uint64 {
+	values := make([]uint64, n)
+	for i := range values {
+		values[i] = src.Uint64()
+	}
+	return values
+}
+
+func TestMarshalBinaryResumesSequence(t *testing.T) {
+	testData := []struct {
+		name     string
+		original checkpointable
+		restored checkpointable
+	}{
+		{name: "XorShift", original: New(42), restored: &XorShift{}},
+		{name: "PCG32", original: NewPCG32(42, 54), restored: &PCG32{}},
+		{name: "Xoshiro256", original: NewXoshiro256(42), restored: &Xoshiro256{}},
+		{name: "SplitMix64", original: NewSplitMix64(42), restored: &SplitMix64{}},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			draw(test.original, 100)
+
+			data, err := test.original.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() failed: %v", err)
+			}
+
+			want := draw(test.original, 1000)
+
+			if err := test.restored.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() failed: %v", err)
+			}
+			if diff := cmp.Diff(want, draw(test.restored, 1000)); diff != "" {
+				t.Errorf("restored sequence mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetStateResumesSequence(t *testing.T) {
+	t.Run("XorShift", func(t *testing.T) {
+		a := NewForPixel(1, 2, 3, 4)
+		draw(a, 10)
+		b := &XorShift{}
+		if err := b.SetState(a.State()); err != nil {
+			t.Fatalf("SetState() failed: %v", err)
+		}
+		if diff := cmp.Diff(draw(a, 100), draw(b, 100)); diff != "" {
+			t.Errorf("restored sequence mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("PCG32", func(t *testing.T) {
+		a := NewPCG32(5, 6)
+		draw(a, 10)
+		b := &PCG32{}
+		if err := b.SetState(a.State()); err != nil {
+			t.Fatalf("SetState() failed: %v", err)
+		}
+		if diff := cmp.Diff(draw(a, 100), draw(b, 100)); diff != "" {
+			t.Errorf("restored sequence mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Xoshiro256", func(t *testing.T) {
+		a := NewXoshiro256(7)
+		a.Jump()
+		b := &Xoshiro256{}
+		if err := b.SetState(a.State()); err != nil {
+			t.Fatalf("SetState() failed: %v", err)
+		}
+		if diff := cmp.Diff(draw(a, 100), draw(b, 100)); diff != "" {
+			t.Errorf("restored sequence mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("SplitMix64", func(t *testing.T) {
+		a := NewSplitMix64(8)
+		draw(a, 10)
+		b := &SplitMix64{}
+		b.SetState(a.State())
+		if diff := cmp.Diff(draw(a, 100), draw(b, 100)); diff != "" {
+			t.Errorf("restored sequence mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	xsData, _ := New(1).MarshalBinary()
+	pcgData, _ := NewPCG32(1, 1).MarshalBinary()
+
+	testData := []struct {
+		name   string
+		target checkpointable
+		data   []byte
+	}{
+		{name: "Empty", target: &XorShift{}, data: nil},
+		{name: "Truncated", target: &XorShift{}, data: xsData[:len(xsData)-1]},
+		{name: "Wrong generator", target: &XorShift{}, data: pcgData},
+		{name: "All zero XorShift", target: &XorShift{}, data: marshalWords(tagXorShift, 0, 0)},
+		{name: "Even PCG32 increment", target: &PCG32{}, data: marshalWords(tagPCG32, 1, 2)},
+		{name: "All zero Xoshiro256", target: &Xoshiro256{}, data: marshalWords(tagXoshiro256, 0, 0, 0, 0)},
+		{name: "SplitMix64 too long", target: &SplitMix64{}, data: marshalWords(tagSplitMix64, 1, 2)},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.target.UnmarshalBinary(test.data); err == nil {
+				t.Errorf("UnmarshalBinary(%x) succeeded, want an error", test.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package fastrandom
+
+import "math/bits"
+
+var (
+	// xoshiroJump advances the state by 2^128 steps.
+	xoshiroJump = [4]uint64{0x180ec6d33cfd0aba, 0xd5a61266f0c9392c, 0xa9582618e03fc9aa, 0x39abdc4529b1661c}
+	// xoshiroLongJump advances the state by 2^192 steps.
+	xoshiroLongJump = [4]uint64{0x76e15d3efefdcbbf, 0xc5004e441c522fb3, 0x77710069854ee241, 0x39109bb02acbe635}
+)
+
+// Xoshiro256 implements Blackman and Vigna's xoshiro256** generator.
+// Its period of 2^256 - 1 can be split into non-overlapping sub-sequences with Jump
+// and LongJump, so that every goroutine gets a provably independent stream.
+type Xoshiro256 struct {
+	s [4]uint64
+}
+
+// NewXoshiro256 returns a new xoshiro256** generator whose state is expanded from seed with SplitMix64.
+func NewXoshiro256(seed uint64) *Xoshiro256 {
+	sm := NewSplitMix64(seed)
+	x := &Xoshiro256{}
+	for i := range x.s {
+		x.s[i] = sm.Uint64()
+	}
+
+	return x
+}
+
+// Uint64 returns the next pseudo-random 64-bit value.
+func (x *Xoshiro256) Uint64() uint64 {
+	result := bits.RotateLeft64(x.s[1]*5, 7) * 9
+
+	t := x.s[1] << 17
+	x.s[2] ^= x.s[0]
+	x.s[3] ^= x.s[1]
+	x.s[1] ^= x.s[2]
+	x.s[0] ^= x.s[3]
+	x.s[2] ^= t
+	x.s[3] = bits.RotateLeft64(x.s[3], 45)
+
+	return result
+}
+
+// Uint32 returns the next pseudo-random 32-bit value.
+func (x *Xoshiro256) Uint32() uint32 {
+	return uint32(x.Uint64() >> 32)
+}
+
+// Intn returns a uniformly distributed value in [0, n). It panics if n <= 0.
+func (x *Xoshiro256) Intn(n int) int {
+	return intn(x.Uint64, n)
+}
+
+// Float32 returns a uniformly distributed value in [0, 1).
+func (x *Xoshiro256) Float32() float32 {
+	return float32FromUint64(x.Uint64())
+}
+
+// Float64 returns a uniformly distributed value in [0, 1).
+func (x *Xoshiro256) Float64() float64 {
+	return float64FromUint64(x.Uint64())
+}
+
+// Float32Range returns a uniformly distributed value in [min, max).
+func (x *Xoshiro256) Float32Range(min, max float32) float32 {
+	return float32Range(x.Float32(), min, max)
+}
+
+// Jump advances the generator by 2^128 steps. Calling it repeatedly on a copy
+// yields 2^128 non-overlapping sub-sequences for parallel use.
+func (x *Xoshiro256) Jump() {
+	x.jump(&xoshiroJump)
+}
+
+// LongJump advances the generator by 2^192 steps. It can be used to create
+// 2^64 starting points, each of which can be split further with Jump.
+func (x *Xoshiro256) LongJump() {
+	x.jump(&xoshiroLongJump)
+}
+
+// jump advances the state by the number of steps encoded in the supplied polynomial.
+func (x *Xoshiro256) jump(poly *[4]uint64) {
+	var s [4]uint64
+	for _, word := range poly {
+		for b := 0; b < 64; b++ {
+			if word&(1<<b) != 0 {
+				s[0] ^= x.s[0]
+				s[1] ^= x.s[1]
+				s[2] ^= x.s[2]
+				s[3] ^= x.s[3]
+			}
+			x.Uint64()
+		}
+	}
+
+	x.s = s
+}
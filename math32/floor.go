@@ -47,3 +47,20 @@ func Round(x float32) float32 {
 
 	return round(x)
 }
+
+// Trunc returns the integer value of x.
+//
+// Special cases are:
+//
+//	Trunc(±0) = ±0
+//	Trunc(±Inf) = ±Inf
+//	Trunc(NaN) = NaN
+func Trunc(x float32) float32 {
+	// Handle special cases
+	if x == 0 || IsNaN(x) || IsInf(x, 0) {
+		return x
+	}
+
+	d, _ := Modf(x)
+	return d
+}
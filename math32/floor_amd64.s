@@ -1,51 +1,48 @@
-//go:build amd64
+//go:build amd64 && !purego
 
 #include "textflag.h"
 
 // func floor(x float32) float32
-TEXT ·floor(SB),NOSPLIT,$0-8
-	// ABI wrapper stores input at 8(SP) and expects output at 16(SP)
-	MOVSS	8(SP), X0        // Load x from stack
+TEXT ·floor(SB),NOSPLIT,$0-12
+	MOVSS	x+0(FP), X0      // Load x
 	ROUNDSS	$1, X0, X0       // Round toward -Inf (floor), mode = 0x01
-	MOVSS	X0, 16(SP)       // Store result
+	MOVSS	X0, ret+8(FP)    // Store result
 	RET
 
 // func ceil(x float32) float32
-TEXT ·ceil(SB),NOSPLIT,$0-8
-	// ABI wrapper stores input at 8(SP) and expects output at 16(SP)
-	MOVSS	8(SP), X0        // Load x from stack
+TEXT ·ceil(SB),NOSPLIT,$0-12
+	MOVSS	x+0(FP), X0      // Load x
 	ROUNDSS	$2, X0, X0       // Round toward +Inf (ceil), mode = 0x02
-	MOVSS	X0, 16(SP)       // Store result
+	MOVSS	X0, ret+8(FP)    // Store result
 	RET
 
 // func round(x float32) float32
-// ROUNDSS doesn't have "ties away from zero" mode, so we use a simpler approach
-TEXT ·round(SB),NOSPLIT,$0-8
-	MOVSS	8(SP), X0        // Load x from stack
-	
-	// Use ROUNDSS mode 0 (round to nearest, ties to even) and adjust
-	// We'll use a different approach: check sign, add 0.5, truncate
-	
-	// Get absolute value and sign
-	MOVSS	X0, X1           // Copy x
+// ROUNDSS has no "ties away from zero" mode, so truncate x and step one away from
+// zero if the discarded fraction is at least one half. Adding 0.5 before truncating
+// would round up values just below one half and odd integers above 2^23.
+TEXT ·round(SB),NOSPLIT,$0-12
+	MOVSS	x+0(FP), X0      // Load x
+	ROUNDSS	$3, X0, X1       // X1 = trunc(x), with the sign of x
+
+	// Fraction |x - trunc(x)|, which is exact
+	MOVSS	X0, X2
+	SUBSS	X1, X2
 	MOVL	$0x7FFFFFFF, AX  // Mask for abs (clear sign bit)
-	MOVD	AX, X2
-	ANDPS	X2, X1           // X1 = |x|
-	
-	// Add 0.5
+	MOVD	AX, X3
+	ANDPS	X3, X2
+
 	MOVL	$0x3F000000, AX  // 0.5
-	MOVD	AX, X2
-	ADDSS	X2, X1           // X1 = |x| + 0.5
-	
-	// Truncate
-	ROUNDSS	$3, X1, X1       // Round toward zero
-	
-	// Copy sign from original x
-	MOVL	$0x80000000, AX  // Sign bit mask
-	MOVD	AX, X2
-	ANDPS	X2, X0           // X0 = sign bit of x
-	ORPS	X0, X1           // X1 = result with correct sign
-	
-	MOVSS	X1, 16(SP)       // Store result
-	RET
+	MOVD	AX, X3
+	UCOMISS	X3, X2
+	JCS	roundDone        // Fraction below one half: trunc(x) is the result
 
+	// Add ±1 with the sign of x
+	MOVL	x+0(FP), AX
+	ANDL	$0x80000000, AX  // Sign bit of x
+	ORL	$0x3F800000, AX  // 1.0
+	MOVD	AX, X3
+	ADDSS	X3, X1
+
+roundDone:
+	MOVSS	X1, ret+8(FP)    // Store result
+	RET
@@ -1,20 +1,23 @@
-//go:build !amd64 && !arm64
+//go:build (!amd64 && !arm64) || purego
 
 package math32
 
 import "math"
 
-// floor provides a software fallback for architectures without assembly implementation.
+// floor provides a software fallback for architectures without assembly implementation
+// and for purego builds.
 func floor(x float32) float32 {
 	return float32(math.Floor(float64(x)))
 }
 
-// ceil provides a software fallback for architectures without assembly implementation.
+// ceil provides a software fallback for architectures without assembly implementation
+// and for purego builds.
 func ceil(x float32) float32 {
 	return float32(math.Ceil(float64(x)))
 }
 
-// round provides a software fallback for architectures without assembly implementation.
+// round provides a software fallback for architectures without assembly implementation
+// and for purego builds.
 func round(x float32) float32 {
 	return float32(math.Round(float64(x)))
 }
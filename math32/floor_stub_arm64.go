@@ -1,4 +1,4 @@
-//go:build arm64
+//go:build arm64 && !purego
 
 package math32
 
@@ -8,5 +8,5 @@ func floor(x float32) float32
 // ceil is implemented in floor_arm64.s using the FRINTP instruction
 func ceil(x float32) float32
 
-// round is implemented in floor_arm64.s using the FRINTN instruction
+// round is implemented in floor_arm64.s using the FRINTA instruction
 func round(x float32) float32
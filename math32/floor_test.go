@@ -430,3 +430,39 @@ func BenchmarkRoundFloat64(b *testing.B) {
 	}
 	_ = result
 }
+
+func TestTrunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+		{"positive infinity", Inf(1), Inf(1)},
+		{"negative infinity", Inf(-1), Inf(-1)},
+		{"NaN", NaN(), NaN()},
+
+		// Fractions truncate towards zero
+		{"0.5", 0.5, 0},
+		{"-0.5", -0.5, Copysign(0, -1)},
+		{"1.9", 1.9, 1},
+		{"-1.9", -1.9, -1},
+		{"123.456", 123.456, 123},
+		{"-123.456", -123.456, -123},
+
+		// Values with no fractional bits
+		{"2^23 + 1", 0x1p23 + 1, 0x1p23 + 1},
+		{"1e10", 1e10, 1e10},
+		{"smallest nonzero", SmallestNonzeroFloat32, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Trunc(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Trunc(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package math32
+
+import "math"
+
+// FMA returns x * y + z, computed with only one rounding.
+// (That is, FMA returns the fused multiply-add of x, y, and z.)
+func FMA(x, y, z float32) float32 {
+	// The product of two float32 values is exact in float64, so the only
+	// rounding of the float64 sum is in the addition. Rounding that sum
+	// to odd and then to float32 avoids the double rounding error.
+	xy := float64(x) * float64(y)
+	r := xy + float64(z)
+
+	if math.IsInf(r, 0) || math.IsNaN(r) {
+		return float32(r)
+	}
+
+	// TwoSum: e is the exact rounding error of xy + z.
+	zz := r - xy
+	e := (xy - (r - zz)) + (float64(z) - zz)
+
+	bits := math.Float64bits(r)
+	if e != 0 && bits&1 == 0 {
+		// Round to odd: move r one unit towards the exact result.
+		if (e > 0) == (r > 0) {
+			bits++
+		} else {
+			bits--
+		}
+	}
+
+	return float32(math.Float64frombits(bits))
+}
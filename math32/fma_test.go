@@ -0,0 +1,81 @@
+package math32
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// fmaExact computes x*y + z exactly and rounds it once to float32.
+func fmaExact(x, y, z float32) float32 {
+	if IsNaN(x) || IsNaN(y) || IsNaN(z) || IsInf(x, 0) || IsInf(y, 0) || IsInf(z, 0) {
+		return float32(float64(x)*float64(y) + float64(z))
+	}
+
+	xy := new(big.Float).SetPrec(512).Mul(big.NewFloat(float64(x)), big.NewFloat(float64(y)))
+	sum := new(big.Float).SetPrec(512).Add(xy, big.NewFloat(float64(z)))
+	if sum.Sign() == 0 {
+		// Exact zero: the sign follows the IEEE rules for x*y + z.
+		return float32(float64(x)*float64(y) + float64(z))
+	}
+
+	f, _ := sum.Float32()
+	return f
+}
+
+func TestFMA(t *testing.T) {
+	tests := []struct {
+		name     string
+		x, y, z  float32
+		expected float32
+	}{
+		// Special cases
+		{"NaN", NaN(), 1, 1, NaN()},
+		{"Inf times zero", Inf(1), 0, 1, NaN()},
+		{"Inf minus Inf", Inf(1), 1, Inf(-1), NaN()},
+		{"Inf", 2, Inf(-1), 1, Inf(-1)},
+		{"signed zero", Copysign(0, -1), 1, Copysign(0, -1), Copysign(0, -1)},
+		{"overflow", MaxFloat32, 2, 0, Inf(1)},
+
+		// Exact product is kept
+		{"cancellation", 1 + 0x1p-23, 1 - 0x1p-23, -1, -0x1p-46},
+
+		// The float64 sum is a tie between two float32 values but the exact result is not
+		{"double rounding", 1 + 0x1p-12, 1 + 0x1p-12, 0x1p-70, 1 + 0x1p-11 + 0x1p-23},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FMA(tt.x, tt.y, tt.z); !identical(got, tt.expected) {
+				t.Errorf("FMA(%v, %v, %v) = %v, want %v", tt.x, tt.y, tt.z, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFMAExact(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		x := math.Float32frombits(random.Uint32())
+		y := math.Float32frombits(random.Uint32())
+		z := math.Float32frombits(random.Uint32())
+		if i%2 == 0 {
+			// Make z cancel most of the product to exercise the rounding.
+			z = -x * y * float32(1+0x1p-10*random.Float64())
+		}
+
+		if got, want := FMA(x, y, z), fmaExact(x, y, z); !identical(got, want) {
+			t.Fatalf("FMA(%v, %v, %v) = %v, want %v", x, y, z, got, want)
+		}
+	}
+}
+
+func BenchmarkFMA(b *testing.B) {
+	x, y, z := float32(1.5), float32(2.5), float32(-3.5)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = FMA(x, y, z)
+	}
+	_ = result
+}
@@ -0,0 +1,46 @@
+package math32
+
+import "math"
+
+// Float32 bit layout used by Frexp, Ldexp and Modf.
+const (
+	mask  = 0xFF
+	shift = 32 - 8 - 1
+	bias  = 127
+
+	smallestNormal = 0x1p-126
+)
+
+// Frexp breaks f into a normalized fraction
+// and an integral power of two.
+// It returns frac and exp satisfying f == frac × 2**exp,
+// with the absolute value of frac in the interval [½, 1).
+//
+// Special cases are:
+//
+//	Frexp(±0) = ±0, 0
+//	Frexp(±Inf) = ±Inf, 0
+//	Frexp(NaN) = NaN, 0
+func Frexp(f float32) (frac float32, exp int) {
+	// Handle special cases
+	if f == 0 || IsNaN(f) || IsInf(f, 0) {
+		return f, 0 // preserves sign of zero
+	}
+
+	f, exp = normalize(f)
+	x := math.Float32bits(f)
+	exp += int((x>>shift)&mask) - bias + 1
+	x &^= mask << shift
+	x |= (-1 + bias) << shift
+
+	return math.Float32frombits(x), exp
+}
+
+// normalize returns a normal number y and exponent exp
+// satisfying x == y × 2**exp. It assumes x is finite and non-zero.
+func normalize(x float32) (y float32, exp int) {
+	if Abs(x) < smallestNormal {
+		return x * (1 << 23), -23
+	}
+	return x, 0
+}
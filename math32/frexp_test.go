@@ -0,0 +1,134 @@
+package math32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestFrexp(t *testing.T) {
+	tests := []struct {
+		name string
+		f    float32
+		frac float32
+		exp  int
+	}{
+		// Special cases
+		{"zero", 0, 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1), 0},
+		{"+Inf", Inf(1), Inf(1), 0},
+		{"-Inf", Inf(-1), Inf(-1), 0},
+		{"NaN", NaN(), NaN(), 0},
+
+		// Normal and denormal values
+		{"one", 1, 0.5, 1},
+		{"-3", -3, -0.75, 2},
+		{"0.1", 0.1, 0.8, -3},
+		{"max", MaxFloat32, 1 - 0x1p-24, 128},
+		{"smallest normal", 0x1p-126, 0.5, -125},
+		{"smallest denormal", SmallestNonzeroFloat32, 0.5, -148},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frac, exp := Frexp(tt.f)
+			if !identical(frac, tt.frac) || exp != tt.exp {
+				t.Errorf("Frexp(%v) = %v, %d, want %v, %d", tt.f, frac, exp, tt.frac, tt.exp)
+			}
+		})
+	}
+}
+
+func TestLdexp(t *testing.T) {
+	tests := []struct {
+		name     string
+		frac     float32
+		exp      int
+		expected float32
+	}{
+		// Special cases
+		{"zero", 0, 10, 0},
+		{"negative zero", Copysign(0, -1), 10, Copysign(0, -1)},
+		{"+Inf", Inf(1), -10, Inf(1)},
+		{"NaN", NaN(), 1, NaN()},
+
+		// Normal results
+		{"one", 0.5, 1, 1},
+		{"negative", -0.75, 2, -3},
+		{"max", 1 - 0x1p-24, 128, MaxFloat32},
+
+		// Overflow and underflow
+		{"overflow", 0.5, 129, Inf(1)},
+		{"negative overflow", -0.5, 1000, Inf(-1)},
+		{"underflow", 0.5, -200, 0},
+		{"negative underflow", -0.5, -200, Copysign(0, -1)},
+
+		// Denormal results are rounded to nearest, ties to even
+		{"smallest denormal", 0.5, -148, SmallestNonzeroFloat32},
+		{"round up to smallest denormal", 0.75, -149, SmallestNonzeroFloat32},
+		{"tie rounds to zero", 0.5, -149, 0},
+		{"tie rounds to even", 0.625, -147, 2 * SmallestNonzeroFloat32},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Ldexp(tt.frac, tt.exp); !identical(got, tt.expected) {
+				t.Errorf("Ldexp(%v, %d) = %v, want %v", tt.frac, tt.exp, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFrexpLdexpMatchFloat64(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		x := math.Float32frombits(random.Uint32())
+
+		frac, exp := Frexp(x)
+		frac64, exp64 := math.Frexp(float64(x))
+		if !identical(frac, float32(frac64)) || exp != exp64 {
+			t.Fatalf("Frexp(%v) = %v, %d, want %v, %d", x, frac, exp, frac64, exp64)
+		}
+
+		if got := Ldexp(frac, exp); !identical(got, x) {
+			t.Fatalf("Ldexp(Frexp(%v)) = %v", x, got)
+		}
+
+		e := random.Intn(400) - 200
+		if got, want := Ldexp(x, e), float32(math.Ldexp(float64(x), e)); !identical(got, want) {
+			t.Fatalf("Ldexp(%v, %d) = %v, want %v", x, e, got, want)
+		}
+	}
+}
+
+func TestModf(t *testing.T) {
+	tests := []struct {
+		name string
+		f    float32
+		int  float32
+		frac float32
+	}{
+		// Special cases
+		{"zero", 0, 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1), Copysign(0, -1)},
+		{"+Inf", Inf(1), Inf(1), NaN()},
+		{"-Inf", Inf(-1), Inf(-1), NaN()},
+		{"NaN", NaN(), NaN(), NaN()},
+
+		// Both parts carry the sign of f
+		{"1.5", 1.5, 1, 0.5},
+		{"-1.5", -1.5, -1, -0.5},
+		{"-0.25", -0.25, Copysign(0, -1), -0.25},
+		{"-3", -3, -3, Copysign(0, -1)},
+		{"large", 1e20, 1e20, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, frac := Modf(tt.f)
+			if !identical(i, tt.int) || !identical(frac, tt.frac) {
+				t.Errorf("Modf(%v) = %v, %v, want %v, %v", tt.f, i, frac, tt.int, tt.frac)
+			}
+		})
+	}
+}
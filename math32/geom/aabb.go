@@ -0,0 +1,146 @@
+package geom
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// slabErrorScale widens the far slab distance to account for the rounding error
+// of the (bound - origin) * invDirection computation.
+const slabErrorScale = 1 + 2*gamma3
+
+// AABB represents an axis-aligned bounding box.
+type AABB struct {
+	Min vec3.Vec3Impl
+	Max vec3.Vec3Impl
+}
+
+// NewAABB returns the smallest bounding box containing the two supplied points.
+func NewAABB(p0, p1 vec3.Vec3Impl) AABB {
+	return AABB{
+		Min: vec3.Min3(p0, p1, p1),
+		Max: vec3.Max3(p0, p1, p1),
+	}
+}
+
+// NewAABBFromTriangle returns the smallest bounding box containing the supplied triangle.
+func NewAABBFromTriangle(v0, v1, v2 vec3.Vec3Impl) AABB {
+	return AABB{
+		Min: vec3.Min3(v0, v1, v2),
+		Max: vec3.Max3(v0, v1, v2),
+	}
+}
+
+// EmptyAABB returns an inverted bounding box that contains nothing and acts
+// as the identity element for Union and Expand.
+func EmptyAABB() AABB {
+	return AABB{
+		Min: vec3.Vec3Impl{X: math32.MaxFloat32, Y: math32.MaxFloat32, Z: math32.MaxFloat32},
+		Max: vec3.Vec3Impl{X: -math32.MaxFloat32, Y: -math32.MaxFloat32, Z: -math32.MaxFloat32},
+	}
+}
+
+// Union returns the smallest bounding box containing both supplied boxes.
+func Union(a, b AABB) AABB {
+	return AABB{
+		Min: vec3.Min3(a.Min, b.Min, b.Min),
+		Max: vec3.Max3(a.Max, b.Max, b.Max),
+	}
+}
+
+// Expand returns the smallest bounding box containing the supplied box and point.
+func Expand(a AABB, p vec3.Vec3Impl) AABB {
+	return AABB{
+		Min: vec3.Min3(a.Min, p, p),
+		Max: vec3.Max3(a.Max, p, p),
+	}
+}
+
+// IsEmpty returns whether this box contains no points.
+func (a AABB) IsEmpty() bool {
+	return a.Min.X > a.Max.X || a.Min.Y > a.Max.Y || a.Min.Z > a.Max.Z
+}
+
+// Diagonal returns the vector from the minimum to the maximum corner of this box.
+func (a AABB) Diagonal() vec3.Vec3Impl {
+	return vec3.Sub(a.Max, a.Min)
+}
+
+// Centroid returns the centre of this box.
+func (a AABB) Centroid() vec3.Vec3Impl {
+	return vec3.ScalarMul(vec3.Add(a.Min, a.Max), 0.5)
+}
+
+// SurfaceArea returns the total area of the six faces of this box.
+func (a AABB) SurfaceArea() float32 {
+	if a.IsEmpty() {
+		return 0
+	}
+
+	d := a.Diagonal()
+	return 2 * (d.X*d.Y + d.X*d.Z + d.Y*d.Z)
+}
+
+// MaximumExtent returns the index of the longest axis of this box, where 0 is X, 1 is Y and 2 is Z.
+func (a AABB) MaximumExtent() int {
+	d := a.Diagonal()
+	if d.X > d.Y && d.X > d.Z {
+		return 0
+	}
+
+	if d.Y > d.Z {
+		return 1
+	}
+
+	return 2
+}
+
+// Hit returns whether the ray intersects this box within its parametric range, together with the
+// entry and exit distances. Rays travelling parallel to a slab and rays grazing a face or edge are
+// handled consistently, and rays with NaN components never hit.
+func (a AABB) Hit(r Ray) (bool, float32, float32) {
+	t0 := r.TMin
+	t1 := r.TMax
+
+	var ok bool
+	if t0, t1, ok = slab(a.Min.X, a.Max.X, r.Origin.X, r.InvDirection.X, t0, t1); !ok {
+		return false, 0, 0
+	}
+
+	if t0, t1, ok = slab(a.Min.Y, a.Max.Y, r.Origin.Y, r.InvDirection.Y, t0, t1); !ok {
+		return false, 0, 0
+	}
+
+	if t0, t1, ok = slab(a.Min.Z, a.Max.Z, r.Origin.Z, r.InvDirection.Z, t0, t1); !ok {
+		return false, 0, 0
+	}
+
+	return true, t0, t1
+}
+
+// slab clips the [t0, t1] interval against a single pair of axis-aligned planes.
+func slab(lo, hi, origin, invDir, t0, t1 float32) (float32, float32, bool) {
+	if math32.IsNaN(origin) || math32.IsNaN(invDir) {
+		return t0, t1, false
+	}
+
+	tNear := (lo - origin) * invDir
+	tFar := (hi - origin) * invDir
+	if tNear > tFar {
+		tNear, tFar = tFar, tNear
+	}
+
+	tFar *= slabErrorScale
+
+	// A ray lying exactly on a slab plane with a zero direction component yields
+	// 0 * Inf = NaN. NaN compares false, so it leaves the interval untouched.
+	if tNear > t0 {
+		t0 = tNear
+	}
+
+	if tFar < t1 {
+		t1 = tFar
+	}
+
+	return t0, t1, t0 <= t1
+}
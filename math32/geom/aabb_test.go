@@ -0,0 +1,184 @@
+package geom
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAABBOperations(t *testing.T) {
+	a := NewAABB(vec3.Vec3Impl{X: 1, Y: -1, Z: 2}, vec3.Vec3Impl{X: -1, Y: 1, Z: 0})
+	b := NewAABBFromTriangle(vec3.Vec3Impl{X: 2}, vec3.Vec3Impl{Y: 3}, vec3.Vec3Impl{Z: -4})
+
+	testData := []struct {
+		name string
+		got  AABB
+		want AABB
+	}{
+		{
+			name: "NewAABB sorts the corners",
+			got:  a,
+			want: AABB{Min: vec3.Vec3Impl{X: -1, Y: -1, Z: 0}, Max: vec3.Vec3Impl{X: 1, Y: 1, Z: 2}},
+		},
+		{
+			name: "Triangle bounds",
+			got:  b,
+			want: AABB{Min: vec3.Vec3Impl{Z: -4}, Max: vec3.Vec3Impl{X: 2, Y: 3}},
+		},
+		{
+			name: "Union",
+			got:  Union(a, b),
+			want: AABB{Min: vec3.Vec3Impl{X: -1, Y: -1, Z: -4}, Max: vec3.Vec3Impl{X: 2, Y: 3, Z: 2}},
+		},
+		{
+			name: "Union with empty box",
+			got:  Union(EmptyAABB(), a),
+			want: a,
+		},
+		{
+			name: "Expand",
+			got:  Expand(a, vec3.Vec3Impl{X: 5, Y: 0, Z: -1}),
+			want: AABB{Min: vec3.Vec3Impl{X: -1, Y: -1, Z: -1}, Max: vec3.Vec3Impl{X: 5, Y: 1, Z: 2}},
+		},
+		{
+			name: "Expand empty box",
+			got:  Expand(EmptyAABB(), vec3.Vec3Impl{X: 1, Y: 2, Z: 3}),
+			want: AABB{Min: vec3.Vec3Impl{X: 1, Y: 2, Z: 3}, Max: vec3.Vec3Impl{X: 1, Y: 2, Z: 3}},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAABBMeasures(t *testing.T) {
+	a := AABB{Min: vec3.Vec3Impl{X: -1, Y: 0, Z: 1}, Max: vec3.Vec3Impl{X: 3, Y: 2, Z: 2}}
+
+	if diff := cmp.Diff(vec3.Vec3Impl{X: 1, Y: 1, Z: 1.5}, a.Centroid()); diff != "" {
+		t.Errorf("Centroid() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got, want := a.SurfaceArea(), float32(2*(4*2+4*1+2*1)); got != want {
+		t.Errorf("SurfaceArea() = %v, want %v", got, want)
+	}
+
+	if got := EmptyAABB().SurfaceArea(); got != 0 {
+		t.Errorf("EmptyAABB().SurfaceArea() = %v, want 0", got)
+	}
+
+	if got := a.MaximumExtent(); got != 0 {
+		t.Errorf("MaximumExtent() = %v, want 0", got)
+	}
+}
+
+func TestAABBHit(t *testing.T) {
+	box := AABB{Min: vec3.Vec3Impl{X: -1, Y: -1, Z: -1}, Max: vec3.Vec3Impl{X: 1, Y: 1, Z: 1}}
+	nan := math32.NaN()
+
+	testData := []struct {
+		name   string
+		ray    Ray
+		want   bool
+		wantT0 float32
+		wantT1 float32
+	}{
+		{
+			name:   "Head on",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{Z: -5}, vec3.Vec3Impl{Z: 1}),
+			want:   true,
+			wantT0: 4,
+			wantT1: 6,
+		},
+		{
+			name:   "Origin inside",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{}, vec3.Vec3Impl{X: 1}),
+			want:   true,
+			wantT0: 0,
+			wantT1: 1,
+		},
+		{
+			name: "Pointing away",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{Z: -5}, vec3.Vec3Impl{Z: -1}),
+		},
+		{
+			name: "Parallel to slab outside the box",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: 2, Z: -5}, vec3.Vec3Impl{Z: 1}),
+		},
+		{
+			name:   "Parallel to slab lying on a face",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 1, Z: -5}, vec3.Vec3Impl{Z: 1}),
+			want:   true,
+			wantT0: 4,
+			wantT1: 6,
+		},
+		{
+			name:   "Parallel to slab along an edge",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 1, Y: -1, Z: -5}, vec3.Vec3Impl{Z: 1}),
+			want:   true,
+			wantT0: 4,
+			wantT1: 6,
+		},
+		{
+			name:   "Negative zero direction components",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{Z: 5}, vec3.Vec3Impl{X: math32.Copysign(0, -1), Y: math32.Copysign(0, -1), Z: -1}),
+			want:   true,
+			wantT0: 4,
+			wantT1: 6,
+		},
+		{
+			name: "Segment ends before the box",
+			ray:  NewRay(vec3.Vec3Impl{Z: -5}, vec3.Vec3Impl{Z: 1}, 0, 3),
+		},
+		{
+			name: "Zero direction",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: 2}, vec3.Vec3Impl{}),
+		},
+		{
+			name: "NaN origin",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: nan, Z: -5}, vec3.Vec3Impl{Z: 1}),
+		},
+		{
+			name: "NaN direction",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{Z: -5}, vec3.Vec3Impl{Y: nan, Z: 1}),
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got, t0, t1 := box.Hit(test.ray)
+			if got != test.want {
+				t.Fatalf("Hit() = %v, want %v", got, test.want)
+			}
+			if !got {
+				return
+			}
+			if math32.Abs(t0-test.wantT0) > 1e-5 || math32.Abs(t1-test.wantT1) > 1e-5 {
+				t.Errorf("Hit() interval = [%v, %v], want [%v, %v]", t0, t1, test.wantT0, test.wantT1)
+			}
+		})
+	}
+}
+
+func TestRayPointAt(t *testing.T) {
+	r := NewInfiniteRay(vec3.Vec3Impl{X: 1, Y: 2, Z: 3}, vec3.Vec3Impl{X: 0, Y: -1, Z: 2})
+	if diff := cmp.Diff(vec3.Vec3Impl{X: 1, Y: 0, Z: 7}, r.PointAt(2)); diff != "" {
+		t.Errorf("PointAt() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func BenchmarkAABBHit(b *testing.B) {
+	box := AABB{Min: vec3.Vec3Impl{X: -1, Y: -1, Z: -1}, Max: vec3.Vec3Impl{X: 1, Y: 1, Z: 1}}
+	r := NewInfiniteRay(vec3.Vec3Impl{X: 0.1, Y: 0.2, Z: -5}, vec3.Vec3Impl{X: 0.01, Y: 0.02, Z: 1})
+	var result bool
+	for i := 0; i < b.N; i++ {
+		result, _, _ = box.Hit(r)
+	}
+	_ = result
+}
@@ -0,0 +1,52 @@
+// Package geom provides geometric primitives and intersection routines.
+package geom
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// gammaN bounds the relative rounding error of N chained float32 operations,
+// see "Physically Based Rendering", section 3.9.
+const (
+	machineEpsilon = 0x1p-24
+	gamma3         = 3 * machineEpsilon / (1 - 3*machineEpsilon)
+	gamma5         = 5 * machineEpsilon / (1 - 5*machineEpsilon)
+	gamma7         = 7 * machineEpsilon / (1 - 7*machineEpsilon)
+)
+
+// Ray represents a ray segment with its origin, direction and valid parametric range.
+type Ray struct {
+	Origin    vec3.Vec3Impl
+	Direction vec3.Vec3Impl
+	// InvDirection holds the per axis reciprocal of Direction.
+	// Zero direction components become infinities of the matching sign.
+	InvDirection vec3.Vec3Impl
+	TMin         float32
+	TMax         float32
+}
+
+// NewRay returns a new ray with the supplied origin, direction and parametric range.
+func NewRay(origin, direction vec3.Vec3Impl, tMin, tMax float32) Ray {
+	return Ray{
+		Origin:       origin,
+		Direction:    direction,
+		InvDirection: vec3.Vec3Impl{X: 1 / direction.X, Y: 1 / direction.Y, Z: 1 / direction.Z},
+		TMin:         tMin,
+		TMax:         tMax,
+	}
+}
+
+// NewInfiniteRay returns a new ray that spans from its origin to infinity.
+func NewInfiniteRay(origin, direction vec3.Vec3Impl) Ray {
+	return NewRay(origin, direction, 0, math32.Inf(1))
+}
+
+// PointAt returns the point along the ray at parameter t.
+func (r Ray) PointAt(t float32) vec3.Vec3Impl {
+	return vec3.Vec3Impl{
+		X: r.Origin.X + t*r.Direction.X,
+		Y: r.Origin.Y + t*r.Direction.Y,
+		Z: r.Origin.Z + t*r.Direction.Z,
+	}
+}
@@ -0,0 +1,48 @@
+package geom
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// IntersectSphere intersects the ray with the sphere of the given centre and radius and returns
+// the distance to the closest hit within the ray's parametric range.
+// The quadratic is solved as described in "Ray Tracing Gems", chapter 7, which avoids the
+// catastrophic cancellation of the textbook formula for distant and small spheres.
+// The boolean result is false if there is no hit within the ray's parametric range.
+func IntersectSphere(r Ray, center vec3.Vec3Impl, radius float32) (float32, bool) {
+	f := vec3.Sub(r.Origin, center)
+	a := vec3.Dot(r.Direction, r.Direction)
+	bPrime := vec3.Dot(f, r.Direction)
+	c := vec3.Dot(f, f) - radius*radius
+
+	// The discriminant b'² - ac is evaluated as a(r² - |f - (b'/a)d|²), which does not
+	// suffer from cancellation when the ray passes far from the centre.
+	l := vec3.Sub(f, vec3.ScalarMul(r.Direction, bPrime/a))
+	discriminant := a * (radius*radius - vec3.Dot(l, l))
+	if !(discriminant >= 0) {
+		return 0, false
+	}
+
+	q := -(bPrime + math32.Copysign(math32.Sqrt(discriminant), bPrime))
+
+	var t0, t1 float32
+	if q != 0 {
+		t0 = c / q
+		t1 = q / a
+	}
+
+	if t0 > t1 {
+		t0, t1 = t1, t0
+	}
+
+	if t0 > r.TMin && t0 < r.TMax {
+		return t0, true
+	}
+
+	if t1 > r.TMin && t1 < r.TMax {
+		return t1, true
+	}
+
+	return 0, false
+}
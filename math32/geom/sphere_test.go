@@ -0,0 +1,102 @@
+package geom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+func TestIntersectSphere(t *testing.T) {
+	center := vec3.Vec3Impl{X: 1, Y: 2, Z: 3}
+
+	testData := []struct {
+		name   string
+		ray    Ray
+		radius float32
+		want   bool
+		wantT  float64
+	}{
+		{
+			name:   "Head on",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 1, Y: 2, Z: -7}, vec3.Vec3Impl{Z: 1}),
+			radius: 2,
+			want:   true,
+			wantT:  8,
+		},
+		{
+			name:   "Unnormalised direction",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 1, Y: 2, Z: -7}, vec3.Vec3Impl{Z: 4}),
+			radius: 2,
+			want:   true,
+			wantT:  2,
+		},
+		{
+			name:   "Origin inside returns the exit",
+			ray:    NewInfiniteRay(center, vec3.Vec3Impl{X: 1}),
+			radius: 2,
+			want:   true,
+			wantT:  2,
+		},
+		{
+			name:   "Sphere behind the ray",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 1, Y: 2, Z: 10}, vec3.Vec3Impl{Z: 1}),
+			radius: 2,
+		},
+		{
+			name:   "Miss",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 4, Y: 2, Z: -7}, vec3.Vec3Impl{Z: 1}),
+			radius: 2,
+		},
+		{
+			name:   "Grazing hit on the silhouette",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 3, Y: 2, Z: -7}, vec3.Vec3Impl{Z: 1}),
+			radius: 2,
+			want:   true,
+			wantT:  10,
+		},
+		{
+			name:   "Grazing miss just outside the silhouette",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 3.0001, Y: 2, Z: -7}, vec3.Vec3Impl{Z: 1}),
+			radius: 2,
+		},
+		{
+			name:   "Small distant sphere",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 1, Y: 2, Z: -99997}, vec3.Vec3Impl{Z: 1}),
+			radius: 0.01,
+			want:   true,
+			wantT:  99999.99,
+		},
+		{
+			name:   "Small distant sphere near the silhouette",
+			ray:    NewInfiniteRay(vec3.Vec3Impl{X: 1.009, Y: 2, Z: -99997}, vec3.Vec3Impl{Z: 1}),
+			radius: 0.01,
+			want:   true,
+			wantT:  99999.99 + 0.01 - math.Sqrt(0.01*0.01-0.009*0.009),
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := IntersectSphere(test.ray, center, test.radius)
+			if ok != test.want {
+				t.Fatalf("IntersectSphere() hit = %v, want %v", ok, test.want)
+			}
+			if !ok {
+				return
+			}
+			if diff := math.Abs(float64(got) - test.wantT); diff > 1e-5*math.Max(1, test.wantT) {
+				t.Errorf("IntersectSphere() = %v, want %v (diff: %e)", got, test.wantT, diff)
+			}
+		})
+	}
+}
+
+func BenchmarkIntersectSphere(b *testing.B) {
+	r := NewInfiniteRay(vec3.Vec3Impl{X: 0.1, Y: 0.2, Z: -5}, vec3.Vec3Impl{X: 0.01, Y: 0.02, Z: 1})
+	var result bool
+	for i := 0; i < b.N; i++ {
+		_, result = IntersectSphere(r, vec3.Vec3Impl{}, 1)
+	}
+	_ = result
+}
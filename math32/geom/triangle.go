@@ -0,0 +1,186 @@
+package geom
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// TriangleHit holds the result of a ray-triangle intersection.
+// The hit point equals B0*v0 + B1*v1 + B2*v2, and B0 + B1 + B2 = 1.
+type TriangleHit struct {
+	T  float32
+	B0 float32
+	B1 float32
+	B2 float32
+}
+
+// IntersectTriangle intersects the ray with the triangle (v0, v1, v2) using the Möller–Trumbore algorithm.
+// It is fast but not watertight: rays hitting an edge shared by two triangles may miss both.
+// The boolean result is false if there is no hit within the ray's parametric range or the triangle is degenerate.
+func IntersectTriangle(r Ray, v0, v1, v2 vec3.Vec3Impl) (TriangleHit, bool) {
+	e1 := vec3.Sub(v1, v0)
+	e2 := vec3.Sub(v2, v0)
+
+	p := vec3.Cross(r.Direction, e2)
+	det := vec3.Dot(e1, p)
+	if det == 0 || math32.IsNaN(det) {
+		return TriangleHit{}, false
+	}
+
+	invDet := 1 / det
+
+	s := vec3.Sub(r.Origin, v0)
+	u := vec3.Dot(s, p) * invDet
+	if u < 0 || u > 1 {
+		return TriangleHit{}, false
+	}
+
+	q := vec3.Cross(s, e1)
+	v := vec3.Dot(r.Direction, q) * invDet
+	if v < 0 || u+v > 1 {
+		return TriangleHit{}, false
+	}
+
+	t := vec3.Dot(e2, q) * invDet
+	if !(t > r.TMin && t < r.TMax) {
+		return TriangleHit{}, false
+	}
+
+	return TriangleHit{T: t, B0: 1 - u - v, B1: u, B2: v}, true
+}
+
+// IntersectTriangleWatertight intersects the ray with the triangle (v0, v1, v2) using the watertight
+// algorithm by Woop, Benthin and Wald, "Watertight Ray/Triangle Intersection", JCGT 2013.
+// Rays hitting an edge or vertex shared by several triangles are guaranteed to hit at least one of them,
+// and the returned distance is conservatively bounded away from the ray origin to avoid self-intersections.
+// The boolean result is false if there is no hit within the ray's parametric range or the triangle is degenerate.
+func IntersectTriangleWatertight(r Ray, v0, v1, v2 vec3.Vec3Impl) (TriangleHit, bool) {
+	// Translate the vertices so that the ray origin sits at (0, 0, 0).
+	p0 := vec3.Sub(v0, r.Origin)
+	p1 := vec3.Sub(v1, r.Origin)
+	p2 := vec3.Sub(v2, r.Origin)
+
+	// Permute the axes so that the dominant direction component becomes Z.
+	kz := maxDimension(vec3.Vec3Impl{X: math32.Abs(r.Direction.X), Y: math32.Abs(r.Direction.Y), Z: math32.Abs(r.Direction.Z)})
+	kx := kz + 1
+	if kx == 3 {
+		kx = 0
+	}
+	ky := kx + 1
+	if ky == 3 {
+		ky = 0
+	}
+
+	// Swapping X and Y when the dominant component is negative preserves the triangle winding.
+	if component(r.Direction, kz) < 0 {
+		kx, ky = ky, kx
+	}
+
+	d := permute(r.Direction, kx, ky, kz)
+	p0 = permute(p0, kx, ky, kz)
+	p1 = permute(p1, kx, ky, kz)
+	p2 = permute(p2, kx, ky, kz)
+
+	// Shear the vertices so that the ray direction becomes (0, 0, 1).
+	// The explicit conversions round every product on its own so that the compiler
+	// cannot fuse it into a multiply-add. Fusing would let two triangles that share
+	// an edge compute different values for it and break watertightness.
+	sx := -d.X / d.Z
+	sy := -d.Y / d.Z
+	sz := 1 / d.Z
+	p0.X += float32(sx * p0.Z)
+	p0.Y += float32(sy * p0.Z)
+	p1.X += float32(sx * p1.Z)
+	p1.Y += float32(sy * p1.Z)
+	p2.X += float32(sx * p2.Z)
+	p2.Y += float32(sy * p2.Z)
+
+	// Edge functions, rounded the same way.
+	e0 := float32(p1.X*p2.Y) - float32(p1.Y*p2.X)
+	e1 := float32(p2.X*p0.Y) - float32(p2.Y*p0.X)
+	e2 := float32(p0.X*p1.Y) - float32(p0.Y*p1.X)
+
+	// Fall back to float64 when an edge function is exactly zero so that
+	// rays through shared edges are classified consistently.
+	if e0 == 0 || e1 == 0 || e2 == 0 {
+		e0 = float32(float64(p1.X)*float64(p2.Y) - float64(p1.Y)*float64(p2.X))
+		e1 = float32(float64(p2.X)*float64(p0.Y) - float64(p2.Y)*float64(p0.X))
+		e2 = float32(float64(p0.X)*float64(p1.Y) - float64(p0.Y)*float64(p1.X))
+	}
+
+	if (e0 < 0 || e1 < 0 || e2 < 0) && (e0 > 0 || e1 > 0 || e2 > 0) {
+		return TriangleHit{}, false
+	}
+
+	det := e0 + e1 + e2
+	if det == 0 || math32.IsNaN(det) {
+		return TriangleHit{}, false
+	}
+
+	// Compute the scaled distance and test it against the ray range without dividing.
+	p0.Z *= sz
+	p1.Z *= sz
+	p2.Z *= sz
+	tScaled := e0*p0.Z + e1*p1.Z + e2*p2.Z
+	if det < 0 && (tScaled >= r.TMin*det || tScaled <= r.TMax*det) {
+		return TriangleHit{}, false
+	}
+	if det > 0 && (tScaled <= r.TMin*det || tScaled >= r.TMax*det) {
+		return TriangleHit{}, false
+	}
+
+	invDet := 1 / det
+	t := tScaled * invDet
+
+	// Ensure that the distance is conservatively greater than zero.
+	maxZt := math32.Max(math32.Max(math32.Abs(p0.Z), math32.Abs(p1.Z)), math32.Abs(p2.Z))
+	deltaZ := gamma3 * maxZt
+
+	maxXt := math32.Max(math32.Max(math32.Abs(p0.X), math32.Abs(p1.X)), math32.Abs(p2.X))
+	maxYt := math32.Max(math32.Max(math32.Abs(p0.Y), math32.Abs(p1.Y)), math32.Abs(p2.Y))
+	deltaX := gamma5 * (maxXt + maxZt)
+	deltaY := gamma5 * (maxYt + maxZt)
+
+	deltaE := 2 * (gamma7*maxXt*maxYt + deltaY*maxXt + deltaX*maxYt)
+
+	maxE := math32.Max(math32.Max(math32.Abs(e0), math32.Abs(e1)), math32.Abs(e2))
+	deltaT := 3 * (gamma3*maxE*maxZt + deltaE*maxZt + deltaZ*maxE) * math32.Abs(invDet)
+	if t <= deltaT {
+		return TriangleHit{}, false
+	}
+
+	return TriangleHit{T: t, B0: e0 * invDet, B1: e1 * invDet, B2: e2 * invDet}, true
+}
+
+// maxDimension returns the index of the largest component of v.
+func maxDimension(v vec3.Vec3Impl) int {
+	if v.X > v.Y {
+		if v.X > v.Z {
+			return 0
+		}
+		return 2
+	}
+
+	if v.Y > v.Z {
+		return 1
+	}
+
+	return 2
+}
+
+// component returns the i-th component of v, where 0 is X, 1 is Y and 2 is Z.
+func component(v vec3.Vec3Impl, i int) float32 {
+	switch i {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	default:
+		return v.Z
+	}
+}
+
+// permute returns a vector made of the x-th, y-th and z-th components of v.
+func permute(v vec3.Vec3Impl, x, y, z int) vec3.Vec3Impl {
+	return vec3.Vec3Impl{X: component(v, x), Y: component(v, y), Z: component(v, z)}
+}
@@ -0,0 +1,176 @@
+package geom
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/fastrandom"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+type triangleIntersector func(Ray, vec3.Vec3Impl, vec3.Vec3Impl, vec3.Vec3Impl) (TriangleHit, bool)
+
+var triangleIntersectors = []struct {
+	name      string
+	intersect triangleIntersector
+}{
+	{"Möller–Trumbore", IntersectTriangle},
+	{"Watertight", IntersectTriangleWatertight},
+}
+
+func TestIntersectTriangle(t *testing.T) {
+	v0 := vec3.Vec3Impl{X: 0, Y: 0, Z: 0}
+	v1 := vec3.Vec3Impl{X: 1, Y: 0, Z: 0}
+	v2 := vec3.Vec3Impl{X: 0, Y: 1, Z: 0}
+
+	testData := []struct {
+		name string
+		ray  Ray
+		want bool
+		hit  TriangleHit
+	}{
+		{
+			name: "Front face",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: 0.25, Y: 0.25, Z: 1}, vec3.Vec3Impl{Z: -1}),
+			want: true,
+			hit:  TriangleHit{T: 1, B0: 0.5, B1: 0.25, B2: 0.25},
+		},
+		{
+			name: "Back face",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: 0.5, Y: 0.25, Z: -2}, vec3.Vec3Impl{Z: 1}),
+			want: true,
+			hit:  TriangleHit{T: 2, B0: 0.25, B1: 0.5, B2: 0.25},
+		},
+		{
+			name: "Oblique",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: -0.75, Y: 0.25, Z: 1}, vec3.Vec3Impl{X: 1, Z: -1}),
+			want: true,
+			hit:  TriangleHit{T: 1, B0: 0.5, B1: 0.25, B2: 0.25},
+		},
+		{
+			name: "Outside",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: 0.75, Y: 0.75, Z: 1}, vec3.Vec3Impl{Z: -1}),
+		},
+		{
+			name: "Behind the origin",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: 0.25, Y: 0.25, Z: -1}, vec3.Vec3Impl{Z: -1}),
+		},
+		{
+			name: "Beyond TMax",
+			ray:  NewRay(vec3.Vec3Impl{X: 0.25, Y: 0.25, Z: 1}, vec3.Vec3Impl{Z: -1}, 0, 0.5),
+		},
+		{
+			name: "Parallel to the plane",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: -1, Y: 0.25, Z: 0}, vec3.Vec3Impl{X: 1}),
+		},
+		{
+			name: "Grazing but above the plane",
+			ray:  NewInfiniteRay(vec3.Vec3Impl{X: -1, Y: 0.25, Z: 1e-6}, vec3.Vec3Impl{X: 1, Z: -1e-7}),
+		},
+	}
+
+	for _, intersector := range triangleIntersectors {
+		for _, test := range testData {
+			t.Run(intersector.name+"/"+test.name, func(t *testing.T) {
+				hit, ok := intersector.intersect(test.ray, v0, v1, v2)
+				if ok != test.want {
+					t.Fatalf("hit = %v, want %v", ok, test.want)
+				}
+				if !ok {
+					return
+				}
+				for _, pair := range [][2]float32{
+					{hit.T, test.hit.T},
+					{hit.B0, test.hit.B0},
+					{hit.B1, test.hit.B1},
+					{hit.B2, test.hit.B2},
+				} {
+					if math32.Abs(pair[0]-pair[1]) > 1e-6 {
+						t.Errorf("hit = %+v, want %+v", hit, test.hit)
+						break
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestIntersectTriangleDegenerate(t *testing.T) {
+	testData := []struct {
+		name       string
+		v0, v1, v2 vec3.Vec3Impl
+	}{
+		{
+			name: "Collinear vertices",
+			v0:   vec3.Vec3Impl{X: -1},
+			v1:   vec3.Vec3Impl{X: 0},
+			v2:   vec3.Vec3Impl{X: 1},
+		},
+		{
+			name: "Repeated vertex",
+			v0:   vec3.Vec3Impl{X: -1},
+			v1:   vec3.Vec3Impl{X: -1},
+			v2:   vec3.Vec3Impl{Y: 1},
+		},
+		{
+			name: "Single point",
+		},
+	}
+
+	r := NewInfiniteRay(vec3.Vec3Impl{Z: 1}, vec3.Vec3Impl{Z: -1})
+
+	for _, intersector := range triangleIntersectors {
+		for _, test := range testData {
+			t.Run(intersector.name+"/"+test.name, func(t *testing.T) {
+				if hit, ok := intersector.intersect(r, test.v0, test.v1, test.v2); ok {
+					t.Errorf("hit a degenerate triangle: %+v", hit)
+				}
+			})
+		}
+	}
+}
+
+func TestIntersectTriangleWatertightSharedEdge(t *testing.T) {
+	// Two triangles forming a quad split along its diagonal. Every ray aimed at the shared
+	// diagonal must hit at least one of them, otherwise the mesh would show cracks.
+	a := vec3.Vec3Impl{X: -1.3, Y: -0.7, Z: 0.1}
+	b := vec3.Vec3Impl{X: 1.1, Y: -0.9, Z: -0.2}
+	c := vec3.Vec3Impl{X: 0.9, Y: 1.7, Z: 0.3}
+	d := vec3.Vec3Impl{X: -1.2, Y: 1.3, Z: -0.1}
+
+	random := fastrandom.New(1234)
+	origin := vec3.Vec3Impl{X: 0.3, Y: -0.2, Z: 7}
+
+	misses := 0
+	for i := 0; i < 100000; i++ {
+		target := vec3.Lerp(a, c, random.Float32())
+		r := NewInfiniteRay(origin, vec3.Sub(target, origin))
+
+		_, hit0 := IntersectTriangleWatertight(r, a, b, c)
+		_, hit1 := IntersectTriangleWatertight(r, a, c, d)
+		if !hit0 && !hit1 {
+			misses++
+		}
+	}
+
+	if misses > 0 {
+		t.Errorf("%d rays slipped through the shared edge", misses)
+	}
+}
+
+func BenchmarkIntersectTriangle(b *testing.B) {
+	v0 := vec3.Vec3Impl{X: 0, Y: 0, Z: 0}
+	v1 := vec3.Vec3Impl{X: 1, Y: 0, Z: 0}
+	v2 := vec3.Vec3Impl{X: 0, Y: 1, Z: 0}
+	r := NewInfiniteRay(vec3.Vec3Impl{X: 0.25, Y: 0.25, Z: 1}, vec3.Vec3Impl{X: 0.01, Y: 0.02, Z: -1})
+
+	for _, intersector := range triangleIntersectors {
+		b.Run(intersector.name, func(b *testing.B) {
+			var result bool
+			for i := 0; i < b.N; i++ {
+				_, result = intersector.intersect(r, v0, v1, v2)
+			}
+			_ = result
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Package half implements IEEE 754 half-precision (binary16) floating-point numbers.
+//
+// Conversions from float32 round to nearest, ties to even, and handle denormals,
+// infinities and NaNs. NaNs keep their sign and the top bits of their payload and are
+// always returned quiet, which matches the F16C and ARMv8 conversion instructions.
+package half
+
+import "math"
+
+// Float16 is an IEEE 754 half-precision floating-point number stored in its binary
+// encoding: 1 sign bit, 5 exponent bits and 10 mantissa bits.
+type Float16 uint16
+
+// Special and limit values.
+const (
+	PositiveZero     Float16 = 0x0000
+	NegativeZero     Float16 = 0x8000
+	PositiveInfinity Float16 = 0x7c00
+	NegativeInfinity Float16 = 0xfc00
+
+	// MaxValue is the largest finite half-precision value, 65504.
+	MaxValue Float16 = 0x7bff
+	// SmallestNormal is the smallest positive normal value, 2^-14.
+	SmallestNormal Float16 = 0x0400
+	// SmallestNonzero is the smallest positive denormal value, 2^-24.
+	SmallestNonzero Float16 = 0x0001
+)
+
+const (
+	signMask     = 0x8000
+	exponentMask = 0x7c00
+	mantissaMask = 0x03ff
+	quietBit     = 0x0200
+)
+
+// NaN returns a quiet half-precision "not-a-number" value.
+func NaN() Float16 {
+	return exponentMask | quietBit
+}
+
+// Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func Inf(sign int) Float16 {
+	if sign >= 0 {
+		return PositiveInfinity
+	}
+	return NegativeInfinity
+}
+
+// IsNaN reports whether h is a "not-a-number" value.
+func (h Float16) IsNaN() bool {
+	return h&exponentMask == exponentMask && h&mantissaMask != 0
+}
+
+// IsInf reports whether h is an infinity, according to sign.
+// If sign > 0, IsInf reports whether h is positive infinity.
+// If sign < 0, IsInf reports whether h is negative infinity.
+// If sign == 0, IsInf reports whether h is either infinity.
+func (h Float16) IsInf(sign int) bool {
+	return sign >= 0 && h == PositiveInfinity || sign <= 0 && h == NegativeInfinity
+}
+
+// Signbit reports whether h is negative or negative zero.
+func (h Float16) Signbit() bool {
+	return h&signMask != 0
+}
+
+// FromFloat32 returns the half-precision value nearest to f, rounding ties to even.
+// Values too large for half precision become infinities and values too small become
+// zeros with the sign of f.
+func FromFloat32(f float32) Float16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & signMask
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		// Infinity or NaN; NaNs keep the top of their payload and are made quiet
+		if mant == 0 {
+			return Float16(sign | exponentMask)
+		}
+		return Float16(sign | exponentMask | quietBit | uint16(mant>>13))
+
+	case exp > 127+15:
+		// Overflow
+		return Float16(sign | exponentMask)
+
+	case exp >= 127-14:
+		// Normal half: rebias the exponent and round the mantissa from 23 to 10 bits.
+		// A carry out of the mantissa correctly increments the exponent, up to infinity.
+		h := uint32(exp-127+15)<<10 | mant>>13
+		rest := mant & 0x1fff
+		if rest > 0x1000 || rest == 0x1000 && h&1 == 1 {
+			h++
+		}
+		return Float16(uint32(sign) | h)
+
+	case exp >= 127-25:
+		// Denormal half: count units of 2^-24, rounding the dropped bits.
+		// A carry into the exponent correctly produces the smallest normal.
+		mant |= 0x800000
+		shift := uint32(126 - exp)
+		h := mant >> shift
+		rest := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rest > halfway || rest == halfway && h&1 == 1 {
+			h++
+		}
+		return Float16(uint32(sign) | h)
+	}
+
+	// Underflow, including float32 denormals
+	return Float16(sign)
+}
+
+// Float32 returns the float32 value of h. Every half-precision value is exactly
+// representable as a float32.
+func (h Float16) Float32() float32 {
+	sign := uint32(h&signMask) << 16
+	exp := uint32(h&exponentMask) >> 10
+	mant := uint32(h & mantissaMask)
+
+	switch exp {
+	case 0x1f:
+		// Infinity or NaN; NaNs are made quiet
+		if mant == 0 {
+			return math.Float32frombits(sign | 0x7f800000)
+		}
+		return math.Float32frombits(sign | 0x7fc00000 | mant<<13)
+
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+
+		// Denormal half: normalize the mantissa
+		e := uint32(127 - 14)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mant&mantissaMask)<<13)
+	}
+
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
@@ -0,0 +1,236 @@
+package half
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// referenceValue decodes h with float64 arithmetic, independently of Float32.
+func referenceValue(h Float16) float64 {
+	sign := 1.0
+	if h&signMask != 0 {
+		sign = -1
+	}
+	exp := int(h&exponentMask) >> 10
+	mant := float64(h & mantissaMask)
+
+	switch exp {
+	case 0x1f:
+		if mant == 0 {
+			return math.Inf(int(sign))
+		}
+		return math.NaN()
+	case 0:
+		return sign * math.Ldexp(mant, -24)
+	}
+	return sign * math.Ldexp(1024+mant, exp-25)
+}
+
+func TestFloat32Exhaustive(t *testing.T) {
+	for i := 0; i < 1<<16; i++ {
+		h := Float16(i)
+		f := h.Float32()
+		want := referenceValue(h)
+
+		if h.IsNaN() {
+			if !math.IsNaN(float64(f)) {
+				t.Fatalf("Float16(%#04x).Float32() = %v, want NaN", i, f)
+			}
+			// NaNs are quieted and keep their sign and payload.
+			if got, want := FromFloat32(f), h|quietBit; got != want {
+				t.Fatalf("FromFloat32(Float16(%#04x).Float32()) = %#04x, want %#04x", i, got, want)
+			}
+			continue
+		}
+
+		if float64(f) != want || math.Signbit(float64(f)) != h.Signbit() {
+			t.Fatalf("Float16(%#04x).Float32() = %v, want %v", i, f, want)
+		}
+		if got := FromFloat32(f); got != h {
+			t.Fatalf("FromFloat32(%v) = %#04x, want %#04x", f, got, h)
+		}
+	}
+}
+
+func TestFromFloat32RoundsToNearestEven(t *testing.T) {
+	// Walk every pair of adjacent finite halves, including the denormals, and check the
+	// float32 values at, just below and just above their midpoint.
+	for i := 0; i < int(MaxValue); i++ {
+		lo, hi := Float16(i), Float16(i+1)
+		mid := float32((referenceValue(lo) + referenceValue(hi)) / 2)
+
+		even := lo
+		if lo&1 == 1 {
+			even = hi
+		}
+
+		testData := []struct {
+			name string
+			f    float32
+			want Float16
+		}{
+			{name: "below midpoint", f: math.Nextafter32(mid, 0), want: lo},
+			{name: "midpoint", f: mid, want: even},
+			{name: "above midpoint", f: math.Nextafter32(mid, 1e9), want: hi},
+		}
+
+		for _, test := range testData {
+			if got := FromFloat32(test.f); got != test.want {
+				t.Fatalf("%s: FromFloat32(%v) = %#04x, want %#04x", test.name, test.f, got, test.want)
+			}
+			if got := FromFloat32(-test.f); got != test.want|signMask {
+				t.Fatalf("%s: FromFloat32(%v) = %#04x, want %#04x", test.name, -test.f, got, test.want|signMask)
+			}
+		}
+	}
+}
+
+func TestFromFloat32SpecialCases(t *testing.T) {
+	testData := []struct {
+		name string
+		f    float32
+		want Float16
+	}{
+		{name: "zero", f: 0, want: PositiveZero},
+		{name: "negative zero", f: float32(math.Copysign(0, -1)), want: NegativeZero},
+		{name: "+Inf", f: float32(math.Inf(1)), want: PositiveInfinity},
+		{name: "-Inf", f: float32(math.Inf(-1)), want: NegativeInfinity},
+		{name: "quiet NaN", f: math.Float32frombits(0x7fc00000), want: 0x7e00},
+		{name: "signaling NaN is quieted", f: math.Float32frombits(0xff802000), want: 0xfe01},
+		{name: "NaN with low payload bits only", f: math.Float32frombits(0x7f800001), want: 0x7e00},
+		{name: "one", f: 1, want: 0x3c00},
+		{name: "max", f: 65504, want: MaxValue},
+		{name: "rounds to max", f: 65519.996, want: MaxValue},
+		{name: "tie rounds to infinity", f: 65520, want: PositiveInfinity},
+		{name: "overflow", f: 1e10, want: PositiveInfinity},
+		{name: "negative overflow", f: -1e10, want: NegativeInfinity},
+		{name: "smallest normal", f: 0x1p-14, want: SmallestNormal},
+		{name: "largest denormal rounds up to normal", f: 0x1p-14 - 0x1p-26, want: SmallestNormal},
+		{name: "smallest denormal", f: 0x1p-24, want: SmallestNonzero},
+		{name: "tie rounds to zero", f: 0x1p-25, want: PositiveZero},
+		{name: "above tie rounds up", f: 0x1p-25 + 0x1p-40, want: SmallestNonzero},
+		{name: "underflow", f: 1e-10, want: PositiveZero},
+		{name: "negative underflow", f: -1e-10, want: NegativeZero},
+		{name: "float32 denormal", f: math.Float32frombits(1), want: PositiveZero},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if got := FromFloat32(test.f); got != test.want {
+				t.Errorf("FromFloat32(%v) = %#04x, want %#04x", test.f, got, test.want)
+			}
+		})
+	}
+}
+
+func TestClassification(t *testing.T) {
+	if !NaN().IsNaN() || PositiveInfinity.IsNaN() || MaxValue.IsNaN() {
+		t.Errorf("IsNaN() misclassifies NaN, infinity or MaxValue")
+	}
+	if !Inf(1).IsInf(1) || !Inf(-1).IsInf(-1) || !Inf(-1).IsInf(0) || Inf(1).IsInf(-1) || NaN().IsInf(0) {
+		t.Errorf("IsInf() misclassifies infinities or NaN")
+	}
+	if !NegativeZero.Signbit() || PositiveZero.Signbit() {
+		t.Errorf("Signbit() misclassifies zeros")
+	}
+}
+
+func TestToFloat32SliceExhaustive(t *testing.T) {
+	src := make([]Float16, 1<<16)
+	for i := range src {
+		src[i] = Float16(i)
+	}
+
+	// Every length up to a few blocks exercises the tail handling.
+	for _, n := range []int{0, 1, 3, 4, 5, 7, 8, 9, 17, len(src)} {
+		dst := make([]float32, n)
+		ToFloat32Slice(dst, src[:n])
+		for i, h := range src[:n] {
+			if want := h.Float32(); math.Float32bits(dst[i]) != math.Float32bits(want) {
+				t.Fatalf("ToFloat32Slice()[%d] = %#x, want %#x", i, math.Float32bits(dst[i]), math.Float32bits(want))
+			}
+		}
+	}
+}
+
+func TestFromFloat32SliceMatchesScalar(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	src := make([]float32, 1<<20)
+	for i := range src {
+		// Random bit patterns cover NaNs, infinities and denormals; the scaled values
+		// concentrate on the half-precision range.
+		if i%2 == 0 {
+			src[i] = math.Float32frombits(random.Uint32())
+		} else {
+			src[i] = float32(random.NormFloat64() * math.Exp2(float64(random.Intn(40)-25)))
+		}
+	}
+
+	for _, n := range []int{0, 1, 3, 4, 5, 7, 8, 9, 17, len(src)} {
+		dst := make([]Float16, n)
+		FromFloat32Slice(dst, src[:n])
+		for i, f := range src[:n] {
+			if want := FromFloat32(f); dst[i] != want {
+				t.Fatalf("FromFloat32Slice()[%d] = %#04x for %#x, want %#04x", i, dst[i], math.Float32bits(f), want)
+			}
+		}
+	}
+}
+
+func TestSliceShortDstPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("FromFloat32Slice() with a short dst did not panic")
+		}
+	}()
+	FromFloat32Slice(make([]Float16, 3), make([]float32, 4))
+}
+
+func BenchmarkFromFloat32(b *testing.B) {
+	src := make([]float32, 4096)
+	for i := range src {
+		src[i] = float32(i) * 0.37
+	}
+	dst := make([]Float16, len(src))
+
+	b.Run("Scalar", func(b *testing.B) {
+		b.SetBytes(int64(4 * len(src)))
+		for i := 0; i < b.N; i++ {
+			for j, f := range src {
+				dst[j] = FromFloat32(f)
+			}
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		b.SetBytes(int64(4 * len(src)))
+		for i := 0; i < b.N; i++ {
+			FromFloat32Slice(dst, src)
+		}
+	})
+}
+
+func BenchmarkToFloat32(b *testing.B) {
+	src := make([]Float16, 4096)
+	for i := range src {
+		src[i] = Float16(i * 13)
+	}
+	dst := make([]float32, len(src))
+
+	b.Run("Scalar", func(b *testing.B) {
+		b.SetBytes(int64(2 * len(src)))
+		for i := 0; i < b.N; i++ {
+			for j, h := range src {
+				dst[j] = h.Float32()
+			}
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		b.SetBytes(int64(2 * len(src)))
+		for i := 0; i < b.N; i++ {
+			ToFloat32Slice(dst, src)
+		}
+	})
+}
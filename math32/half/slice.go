@@ -0,0 +1,37 @@
+package half
+
+// FromFloat32Slice converts every element of src with FromFloat32 and stores the
+// results in dst. It panics if dst is shorter than src.
+//
+// On amd64 with F16C and on arm64 the bulk of the work uses the hardware conversion
+// instructions, which give the same results as FromFloat32, unless the purego build
+// tag is set.
+func FromFloat32Slice(dst []Float16, src []float32) {
+	dst = dst[:len(src)]
+	fromFloat32Slice(dst, src)
+}
+
+// ToFloat32Slice converts every element of src to float32 and stores the results in
+// dst. It panics if dst is shorter than src.
+//
+// On amd64 with F16C and on arm64 the bulk of the work uses the hardware conversion
+// instructions, which give the same results as Float16.Float32, unless the purego
+// build tag is set.
+func ToFloat32Slice(dst []float32, src []Float16) {
+	dst = dst[:len(src)]
+	toFloat32Slice(dst, src)
+}
+
+// fromFloat32SliceGeneric is the pure Go implementation of FromFloat32Slice.
+func fromFloat32SliceGeneric(dst []Float16, src []float32) {
+	for i := range src {
+		dst[i] = FromFloat32(src[i])
+	}
+}
+
+// toFloat32SliceGeneric is the pure Go implementation of ToFloat32Slice.
+func toFloat32SliceGeneric(dst []float32, src []Float16) {
+	for i := range src {
+		dst[i] = src[i].Float32()
+	}
+}
@@ -0,0 +1,29 @@
+//go:build amd64 && !purego
+
+package half
+
+import "github.com/flynn-nrg/go-vfx/math32/internal/cpu"
+
+// fromFloat32BlocksF16C converts blocks of eight elements with VCVTPS2PH and returns
+// the number of elements converted.
+func fromFloat32BlocksF16C(dst []Float16, src []float32) int
+
+// toFloat32BlocksF16C converts blocks of eight elements with VCVTPH2PS and returns
+// the number of elements converted.
+func toFloat32BlocksF16C(dst []float32, src []Float16) int
+
+func fromFloat32Slice(dst []Float16, src []float32) {
+	n := 0
+	if cpu.HasF16C {
+		n = fromFloat32BlocksF16C(dst, src)
+	}
+	fromFloat32SliceGeneric(dst[n:], src[n:])
+}
+
+func toFloat32Slice(dst []float32, src []Float16) {
+	n := 0
+	if cpu.HasF16C {
+		n = toFloat32BlocksF16C(dst, src)
+	}
+	toFloat32SliceGeneric(dst[n:], src[n:])
+}
@@ -0,0 +1,51 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// func fromFloat32BlocksF16C(dst []Float16, src []float32) int
+TEXT ·fromFloat32BlocksF16C(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	SHRQ	$3, CX                   // Number of 8-lane blocks
+	MOVQ	CX, AX
+	SHLQ	$3, AX                   // Number of elements converted
+	TESTQ	CX, CX
+	JZ	fromDone
+
+fromLoop:
+	VMOVUPS	(SI), Y0
+	VCVTPS2PH	$0, Y0, (DI)       // Round to nearest even
+	ADDQ	$32, SI
+	ADDQ	$16, DI
+	DECQ	CX
+	JNZ	fromLoop
+
+fromDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
+
+// func toFloat32BlocksF16C(dst []float32, src []Float16) int
+TEXT ·toFloat32BlocksF16C(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	SHRQ	$3, CX                   // Number of 8-lane blocks
+	MOVQ	CX, AX
+	SHLQ	$3, AX                   // Number of elements converted
+	TESTQ	CX, CX
+	JZ	toDone
+
+toLoop:
+	VCVTPH2PS	(SI), Y0
+	VMOVUPS	Y0, (DI)
+	ADDQ	$16, SI
+	ADDQ	$32, DI
+	DECQ	CX
+	JNZ	toLoop
+
+toDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
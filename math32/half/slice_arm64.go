@@ -0,0 +1,21 @@
+//go:build arm64 && !purego
+
+package half
+
+// fromFloat32BlocksNEON converts blocks of four elements with FCVTN and returns
+// the number of elements converted.
+func fromFloat32BlocksNEON(dst []Float16, src []float32) int
+
+// toFloat32BlocksNEON converts blocks of four elements with FCVTL and returns
+// the number of elements converted.
+func toFloat32BlocksNEON(dst []float32, src []Float16) int
+
+func fromFloat32Slice(dst []Float16, src []float32) {
+	n := fromFloat32BlocksNEON(dst, src)
+	fromFloat32SliceGeneric(dst[n:], src[n:])
+}
+
+func toFloat32Slice(dst []float32, src []Float16) {
+	n := toFloat32BlocksNEON(dst, src)
+	toFloat32SliceGeneric(dst[n:], src[n:])
+}
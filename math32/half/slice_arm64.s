@@ -0,0 +1,41 @@
+//go:build arm64 && !purego
+
+#include "textflag.h"
+
+// The vector instructions are encoded with WORD so that older assemblers accept them.
+
+// func fromFloat32BlocksNEON(dst []Float16, src []float32) int
+TEXT ·fromFloat32BlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	LSL	$2, R3, R4               // Number of elements converted
+	CBZ	R3, fromDone
+fromLoop:
+	VLD1.P	16(R1), [V0.S4]
+	WORD	$0x0e216800              // FCVTN V0.4H, V0.4S
+	VST1.P	[V0.H4], 8(R0)
+	SUB	$1, R3, R3
+	CBNZ	R3, fromLoop
+fromDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// func toFloat32BlocksNEON(dst []float32, src []Float16) int
+TEXT ·toFloat32BlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	LSL	$2, R3, R4               // Number of elements converted
+	CBZ	R3, toDone
+toLoop:
+	VLD1.P	8(R1), [V0.H4]
+	WORD	$0x0e217800              // FCVTL V0.4S, V0.4H
+	VST1.P	[V0.S4], 16(R0)
+	SUB	$1, R3, R3
+	CBNZ	R3, toLoop
+toDone:
+	MOVD	R4, ret+48(FP)
+	RET
@@ -0,0 +1,11 @@
+//go:build (!amd64 && !arm64) || purego
+
+package half
+
+func fromFloat32Slice(dst []Float16, src []float32) {
+	fromFloat32SliceGeneric(dst, src)
+}
+
+func toFloat32Slice(dst []float32, src []Float16) {
+	toFloat32SliceGeneric(dst, src)
+}
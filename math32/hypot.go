@@ -0,0 +1,34 @@
+package math32
+
+// Hypot returns Sqrt(p*p + q*q), taking care to avoid
+// unnecessary overflow and underflow.
+//
+// Special cases are:
+//
+//	Hypot(±Inf, q) = +Inf
+//	Hypot(p, ±Inf) = +Inf
+//	Hypot(NaN, q) = NaN
+//	Hypot(p, NaN) = NaN
+func Hypot(p, q float32) float32 {
+	p, q = Abs(p), Abs(q)
+
+	// Handle special cases
+	if IsInf(p, 1) || IsInf(q, 1) {
+		return Inf(1)
+	}
+
+	if IsNaN(p) || IsNaN(q) {
+		return NaN()
+	}
+
+	if p < q {
+		p, q = q, p
+	}
+
+	if p == 0 {
+		return 0
+	}
+
+	q = q / p
+	return p * Sqrt(1+q*q)
+}
@@ -0,0 +1,67 @@
+package math32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHypot(t *testing.T) {
+	tests := []struct {
+		name     string
+		p, q     float32
+		expected float32
+	}{
+		// Special cases
+		{"+Inf", Inf(1), 1, Inf(1)},
+		{"-Inf", 1, Inf(-1), Inf(1)},
+		{"Inf and NaN", NaN(), Inf(-1), Inf(1)},
+		{"NaN", NaN(), 1, NaN()},
+		{"zeros", Copysign(0, -1), 0, 0},
+
+		// Exact results
+		{"3-4-5", 3, -4, 5},
+		{"axis", -7, 0, 7},
+
+		// No intermediate overflow or underflow
+		{"large", 3e38, 4e37, float32(math.Hypot(3e38, 4e37))},
+		{"tiny", 3e-40, 4e-40, float32(math.Hypot(3e-40, 4e-40))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hypot(tt.p, tt.q); ulpDiff(got, tt.expected) > 2 {
+				t.Errorf("Hypot(%v, %v) = %v, want %v", tt.p, tt.q, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHypotULP(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	ps := magnitudeInputs(100000, -38, 38)
+
+	var worst uint32
+	for _, p := range ps {
+		q := p * float32(4*random.Float64()-2)
+		want := float32(math.Hypot(float64(p), float64(q)))
+		if d := ulpDiff(Hypot(p, q), want); d > worst {
+			worst = d
+		}
+	}
+
+	t.Logf("Hypot: maximum error %d ULP", worst)
+
+	if worst > 2 {
+		t.Errorf("Hypot: maximum error %d ULP exceeds tolerance", worst)
+	}
+}
+
+func BenchmarkHypot(b *testing.B) {
+	p, q := float32(3), float32(4)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Hypot(p, q)
+	}
+	_ = result
+}
@@ -0,0 +1,131 @@
+package math32
+
+import (
+	"math"
+	"testing"
+)
+
+// NaNs with a payload and with the sign bit set, to check that they are passed through.
+var (
+	payloadNaN  = math.Float32frombits(0x7FC12345)
+	negativeNaN = math.Float32frombits(0xFFC00001)
+	negZero     = Copysign(0, -1)
+)
+
+// TestIEEEContract checks the results that must be bit-for-bit the same on amd64,
+// arm64 and generic builds, including the sign of zero and NaN payloads.
+func TestIEEEContract(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float32
+		want float32
+	}{
+		{"Sqrt(+0)", Sqrt(0), 0},
+		{"Sqrt(-0)", Sqrt(negZero), negZero},
+		{"Sqrt(+Inf)", Sqrt(Inf(1)), Inf(1)},
+		{"Sqrt(-Inf)", Sqrt(Inf(-1)), NaN()},
+		{"Sqrt(-1)", Sqrt(-1), NaN()},
+		{"Sqrt(-denormal)", Sqrt(-SmallestNonzeroFloat32), NaN()},
+		{"Sqrt(payload NaN)", Sqrt(payloadNaN), payloadNaN},
+		{"Sqrt(negative NaN)", Sqrt(negativeNaN), negativeNaN},
+		{"Sqrt(denormal)", Sqrt(0x1p-148), 0x1p-74},
+
+		{"Max(-0, +0)", Max(negZero, 0), 0},
+		{"Max(+0, -0)", Max(0, negZero), 0},
+		{"Max(-0, -0)", Max(negZero, negZero), negZero},
+		{"Max(payload NaN, 1)", Max(payloadNaN, 1), payloadNaN},
+		{"Max(1, payload NaN)", Max(1, payloadNaN), payloadNaN},
+		{"Max(+Inf, payload NaN)", Max(Inf(1), payloadNaN), payloadNaN},
+		{"Max(negative NaN, payload NaN)", Max(negativeNaN, payloadNaN), negativeNaN},
+		{"Max(-1, -Inf)", Max(-1, Inf(-1)), -1},
+
+		{"Min(-0, +0)", Min(negZero, 0), negZero},
+		{"Min(+0, -0)", Min(0, negZero), negZero},
+		{"Min(+0, +0)", Min(0, 0), 0},
+		{"Min(payload NaN, 1)", Min(payloadNaN, 1), payloadNaN},
+		{"Min(1, payload NaN)", Min(1, payloadNaN), payloadNaN},
+		{"Min(-Inf, payload NaN)", Min(Inf(-1), payloadNaN), payloadNaN},
+		{"Min(negative NaN, payload NaN)", Min(negativeNaN, payloadNaN), negativeNaN},
+		{"Min(1, +Inf)", Min(1, Inf(1)), 1},
+
+		{"Floor(-0)", Floor(negZero), negZero},
+		{"Floor(0.5)", Floor(0.5), 0},
+		{"Floor(-0.5)", Floor(-0.5), -1},
+		{"Floor(-denormal)", Floor(-SmallestNonzeroFloat32), -1},
+		{"Floor(-(2^23+1))", Floor(-8388609), -8388609},
+		{"Floor(payload NaN)", Floor(payloadNaN), payloadNaN},
+		{"Floor(negative NaN)", Floor(negativeNaN), negativeNaN},
+
+		{"Ceil(-0)", Ceil(negZero), negZero},
+		{"Ceil(-0.5)", Ceil(-0.5), negZero},
+		{"Ceil(denormal)", Ceil(SmallestNonzeroFloat32), 1},
+		{"Ceil(2^23+1)", Ceil(8388609), 8388609},
+		{"Ceil(payload NaN)", Ceil(payloadNaN), payloadNaN},
+
+		{"Round(-0)", Round(negZero), negZero},
+		{"Round(-0.4)", Round(-0.4), negZero},
+		{"Round(0.5)", Round(0.5), 1},
+		{"Round(-0.5)", Round(-0.5), -1},
+		{"Round(1.5)", Round(1.5), 2},
+		{"Round(2.5)", Round(2.5), 3},
+		{"Round(-2.5)", Round(-2.5), -3},
+		{"Round(largest below 0.5)", Round(0.49999997), 0},
+		{"Round(-largest below 0.5)", Round(-0.49999997), negZero},
+		{"Round(2^23-0.5)", Round(8388607.5), 8388608},
+		{"Round(2^23+1)", Round(8388609), 8388609},
+		{"Round(-(2^24-1))", Round(-16777215), -16777215},
+		{"Round(MaxFloat32)", Round(MaxFloat32), MaxFloat32},
+		{"Round(-Inf)", Round(Inf(-1)), Inf(-1)},
+		{"Round(payload NaN)", Round(payloadNaN), payloadNaN},
+
+		{"Trunc(-0.5)", Trunc(-0.5), negZero},
+		{"Trunc(payload NaN)", Trunc(payloadNaN), payloadNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Float32bits(tt.got) != math.Float32bits(tt.want) {
+				t.Errorf("%s = %v (%#08x), want %v (%#08x)",
+					tt.name, tt.got, math.Float32bits(tt.got), tt.want, math.Float32bits(tt.want))
+			}
+		})
+	}
+}
+
+// TestIEEEContractSlice checks that the vector kernels follow the same contract as the
+// scalar functions for the special values.
+func TestIEEEContractSlice(t *testing.T) {
+	special := []float32{0, negZero, Inf(1), Inf(-1), payloadNaN, negativeNaN, -1, 0.5,
+		-0.5, -SmallestNonzeroFloat32, SmallestNonzeroFloat32, -8388609, 8388609, 4, 2, -0.4}
+	// Repeat the values so that every one of them also lands in a block of its own kind.
+	var src []float32
+	for _, x := range special {
+		for i := 0; i < 8; i++ {
+			src = append(src, x)
+		}
+	}
+	src = append(src, special...)
+
+	tests := []struct {
+		name   string
+		slice  func(dst, src []float32)
+		scalar func(x float32) float32
+	}{
+		{"Sqrt", SqrtSlice, Sqrt},
+		{"Floor", FloorSlice, Floor},
+		{"Ceil", CeilSlice, Ceil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := make([]float32, len(src))
+			tt.slice(dst, src)
+			for i, x := range src {
+				if want := tt.scalar(x); math.Float32bits(dst[i]) != math.Float32bits(want) {
+					t.Errorf("%sSlice()[%d] = %#08x for %v, want %#08x",
+						tt.name, i, math.Float32bits(dst[i]), x, math.Float32bits(want))
+				}
+			}
+		})
+	}
+}
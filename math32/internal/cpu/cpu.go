@@ -0,0 +1,11 @@
+// Package cpu reports the processor features used by the assembly kernels of math32 and
+// its subpackages. The features are always false on other architectures and in purego
+// builds.
+package cpu
+
+var (
+	// HasAVX2 reports whether both the CPU and the operating system support AVX2.
+	HasAVX2 bool
+	// HasF16C reports whether both the CPU and the operating system support F16C.
+	HasF16C bool
+)
@@ -0,0 +1,32 @@
+//go:build !purego
+
+package cpu
+
+// cpuid is implemented in cpu_amd64.s and executes the CPUID instruction.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv is implemented in cpu_amd64.s and reads the XCR0 register.
+func xgetbv() (eax, edx uint32)
+
+func init() {
+	// OSXSAVE, AVX and F16C are bits 27, 28 and 29 of ECX in leaf 1.
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&(1<<27) == 0 || ecx1&(1<<28) == 0 {
+		return
+	}
+
+	// The operating system must save the XMM and YMM registers on context switches.
+	xcr0, _ := xgetbv()
+	if xcr0&0x6 != 0x6 {
+		return
+	}
+
+	HasF16C = ecx1&(1<<29) != 0
+
+	// AVX2 is bit 5 of EBX in leaf 7.
+	maxLeaf, _, _, _ := cpuid(0, 0)
+	if maxLeaf >= 7 {
+		_, ebx7, _, _ := cpuid(7, 0)
+		HasAVX2 = ebx7&(1<<5) != 0
+	}
+}
@@ -0,0 +1,43 @@
+package math32
+
+import "math"
+
+// Ldexp is the inverse of Frexp.
+// It returns frac × 2**exp, correctly rounded when the result is denormal.
+//
+// Special cases are:
+//
+//	Ldexp(±0, exp) = ±0
+//	Ldexp(±Inf, exp) = ±Inf
+//	Ldexp(NaN, exp) = NaN
+func Ldexp(frac float32, exp int) float32 {
+	// Handle special cases
+	if frac == 0 || IsNaN(frac) || IsInf(frac, 0) {
+		return frac // preserves sign of zero
+	}
+
+	frac, e := normalize(frac)
+	exp += e
+	x := math.Float32bits(frac)
+	exp += int(x>>shift)&mask - bias
+
+	if exp < -150 {
+		return Copysign(0, frac) // underflow
+	}
+
+	if exp > 127 {
+		return Copysign(Inf(1), frac) // overflow
+	}
+
+	var m float32 = 1
+	if exp < -126 {
+		// Denormal result: build a normal number and let the final
+		// multiplication round it.
+		exp += 24
+		m = 1.0 / (1 << 24)
+	}
+	x &^= mask << shift
+	x |= uint32(exp+bias) << shift
+
+	return m * math.Float32frombits(x)
+}
@@ -34,6 +34,21 @@ func Log(x float32) float32 {
 		return x // log(+Inf) = +Inf
 	}
 
+	k, f := logReduce(x)
+
+	// Compute log(f) where f is in [sqrt(2)/2, sqrt(2)]
+	// Transform to [-1/3, 1/3] using: s = (f-1)/(f+1)
+	s := (f - 1) / (f + 1)
+
+	// log(f) = 2s + 2s³/3 + 2s⁵/5 + ... = 2s(1 + s²/3 + s⁴/5 + ...)
+	logF := logKernel(s)
+
+	// Final result: log(x) = k·ln(2) + log(f)
+	return float32(k)*ln2Hi + (float32(k)*ln2Lo + logF)
+}
+
+// logReduce splits a positive finite x into x = 2^k·f with f in [sqrt(2)/2, sqrt(2)].
+func logReduce(x float32) (k int32, f float32) {
 	// Extract exponent and mantissa using bit manipulation
 	bits := math.Float32bits(x)
 
@@ -49,11 +64,11 @@ func Log(x float32) float32 {
 	}
 
 	// Unbias exponent (float32 uses bias of 127)
-	k := exp - 127
+	k = exp - 127
 
 	// Get mantissa and set exponent to 127 (value in [1, 2))
 	bits = (bits & 0x807FFFFF) | (127 << 23)
-	f := math.Float32frombits(bits)
+	f = math.Float32frombits(bits)
 
 	// Now f is in [1, 2)
 	// Reduce to [sqrt(2)/2, sqrt(2)] for better polynomial accuracy
@@ -62,15 +77,7 @@ func Log(x float32) float32 {
 		k++
 	}
 
-	// Compute log(f) where f is in [sqrt(2)/2, sqrt(2)]
-	// Transform to [-1/3, 1/3] using: s = (f-1)/(f+1)
-	s := (f - 1) / (f + 1)
-
-	// log(f) = 2s + 2s³/3 + 2s⁵/5 + ... = 2s(1 + s²/3 + s⁴/5 + ...)
-	logF := logKernel(s)
-
-	// Final result: log(x) = k·ln(2) + log(f)
-	return float32(k)*ln2Hi + (float32(k)*ln2Lo + logF)
+	return k, f
 }
 
 // logKernel evaluates log(f) for f in [sqrt(2)/2, sqrt(2)]
@@ -91,8 +98,9 @@ func logKernel(s float32) float32 {
 
 	s2 := s * s
 
-	// Polynomial: 2s(1 + s²(L1 + s²(L2 + s²(L3 + s²(L4 + s²(L5 + s²·L6))))))
+	// Polynomial: s(2 + s²(L1 + s²(L2 + s²(L3 + s²(L4 + s²(L5 + s²·L6))))))
+	// The coefficients already include the factor 2 of the series.
 	poly := L1 + s2*(L2+s2*(L3+s2*(L4+s2*(L5+s2*L6))))
 
-	return 2 * s * (1 + s2*poly)
+	return s * (2 + s2*poly)
 }
@@ -0,0 +1,35 @@
+package math32
+
+// Log10 returns the decimal logarithm of x.
+// The special cases are the same as for Log.
+func Log10(x float32) float32 {
+	return Log(x) * (1 / Ln10)
+}
+
+// Log2 returns the binary logarithm of x.
+// Exact powers of two return exact results.
+// The special cases are the same as for Log.
+func Log2(x float32) float32 {
+	// Handle special cases
+	if IsNaN(x) {
+		return x
+	}
+
+	if x < 0 {
+		return NaN()
+	}
+
+	if x == 0 {
+		return Inf(-1)
+	}
+
+	if IsInf(x, 1) {
+		return x
+	}
+
+	// log2(x) = k + log(f)/ln(2); for powers of two f == 1 and log(f) == 0 exactly
+	k, f := logReduce(x)
+	logF := logKernel((f - 1) / (f + 1))
+
+	return float32(k) + logF*Log2E
+}
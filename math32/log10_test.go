@@ -0,0 +1,88 @@
+package math32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLog2(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"NaN", NaN(), NaN()},
+		{"+Inf", Inf(1), Inf(1)},
+		{"zero", 0, Inf(-1)},
+		{"negative", -1, NaN()},
+
+		// Powers of two are exact
+		{"one", 1, 0},
+		{"two", 2, 1},
+		{"1024", 1024, 10},
+		{"0.125", 0.125, -3},
+		{"2^127", 0x1p127, 127},
+		{"smallest normal", 0x1p-126, -126},
+		{"smallest denormal", SmallestNonzeroFloat32, -149},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Log2(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Log2(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLog10(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"NaN", NaN(), NaN()},
+		{"+Inf", Inf(1), Inf(1)},
+		{"zero", 0, Inf(-1)},
+		{"negative", -1, NaN()},
+		{"one", 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Log10(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Log10(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLog2ULP(t *testing.T) {
+	checkULP(t, "Log2", Log2, math.Log2, magnitudeInputs(100000, -44, 38), 4)
+	checkULP(t, "Log2", Log2, math.Log2, uniformInputs(100000, 0.5, 2), 4)
+}
+
+func TestLog10ULP(t *testing.T) {
+	checkULP(t, "Log10", Log10, math.Log10, magnitudeInputs(100000, -44, 38), 4)
+	checkULP(t, "Log10", Log10, math.Log10, uniformInputs(100000, 0.5, 2), 4)
+}
+
+func BenchmarkLog2(b *testing.B) {
+	x := float32(100.0)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Log2(x)
+	}
+	_ = result
+}
+
+func BenchmarkLog10(b *testing.B) {
+	x := float32(100.0)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Log10(x)
+	}
+	_ = result
+}
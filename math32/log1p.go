@@ -0,0 +1,40 @@
+package math32
+
+// Log1p returns the natural logarithm of 1 plus its argument x.
+// It is more accurate than Log(1 + x) when x is near zero.
+//
+// Special cases are:
+//
+//	Log1p(+Inf) = +Inf
+//	Log1p(±0) = ±0
+//	Log1p(-1) = -Inf
+//	Log1p(x < -1) = NaN
+//	Log1p(NaN) = NaN
+func Log1p(x float32) float32 {
+	// Handle special cases
+	if x == 0 || IsNaN(x) {
+		return x // preserves sign of zero
+	}
+
+	if x < -1 {
+		return NaN()
+	}
+
+	if x == -1 {
+		return Inf(-1)
+	}
+
+	if IsInf(x, 1) {
+		return x
+	}
+
+	u := 1 + x
+	if u == 1 {
+		// x is so small that 1+x rounds to 1, and log(1+x) ≈ x
+		return x
+	}
+
+	// Kahan's trick: log(u)·x/(u-1) compensates for the rounding error in 1+x.
+	// The ratio is close to 1, so evaluating it first avoids overflow.
+	return Log(u) * (x / (u - 1))
+}
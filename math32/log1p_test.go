@@ -0,0 +1,50 @@
+package math32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLog1p(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"NaN", NaN(), NaN()},
+		{"+Inf", Inf(1), Inf(1)},
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+		{"-1", -1, Inf(-1)},
+		{"below -1", -1.5, NaN()},
+		{"-Inf", Inf(-1), NaN()},
+
+		// Tiny arguments return themselves
+		{"1e-10", 1e-10, 1e-10},
+		{"-1e-10", -1e-10, -1e-10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Log1p(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Log1p(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLog1pULP(t *testing.T) {
+	checkULP(t, "Log1p", Log1p, math.Log1p, uniformInputs(100000, -0.999, 10), 4)
+	checkULP(t, "Log1p", Log1p, math.Log1p, uniformInputs(100000, -1e-3, 1e-3), 4)
+	checkULP(t, "Log1p", Log1p, math.Log1p, magnitudeInputs(100000, -30, 38), 4)
+}
+
+func BenchmarkLog1p(b *testing.B) {
+	x := float32(0.001)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Log1p(x)
+	}
+	_ = result
+}
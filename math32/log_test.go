@@ -175,6 +175,38 @@ func TestLogAccuracy(t *testing.T) {
 	}
 }
 
+func TestLogULP(t *testing.T) {
+	checkULP(t, "Log", Log, math.Log, magnitudeInputs(100000, -44, 38), 3)
+	checkULP(t, "Log", Log, math.Log, uniformInputs(100000, 0.5, 2), 3)
+}
+
+func TestLogNearSqrt2(t *testing.T) {
+	// The error of the series is largest at the ends of the reduced range, [sqrt(2)/2, sqrt(2)].
+	tests := []struct {
+		name  string
+		input float32
+	}{
+		{"sqrt(2)/2", 0.70710677},
+		{"0.75", 0.75},
+		{"0.9", 0.9},
+		{"1.2", 1.2},
+		{"1.4", 1.4},
+		{"sqrt(2)", 1.4142135},
+		{"2*sqrt(2)", 2.828427},
+		{"sqrt(2)*2^40", 1.4142135 * (1 << 40)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := float64(Log(tt.input))
+			expected := math.Log(float64(tt.input))
+			if err := math.Abs(got-expected) / math.Abs(expected); err > 1e-6 {
+				t.Errorf("Log(%v) = %v, want %v (rel error: %e)", tt.input, got, expected, err)
+			}
+		})
+	}
+}
+
 func TestLogMonotonicity(t *testing.T) {
 	// Log should be strictly increasing for positive values
 	prev := Log(1e-38)
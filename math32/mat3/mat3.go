@@ -1,8 +1,12 @@
 // Package mat3 implements functions to work with 3x3 matrices.
 package mat3
 
-import "github.com/flynn-nrg/go-vfx/math32/vec3"
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
 
+// Mat3 represents a 3x3 matrix in row-major order.
 type Mat3 struct {
 	A11 float32
 	A12 float32
@@ -15,6 +19,15 @@ type Mat3 struct {
 	A33 float32
 }
 
+// Identity returns the 3x3 identity matrix.
+func Identity() Mat3 {
+	return Mat3{
+		A11: 1,
+		A22: 1,
+		A33: 1,
+	}
+}
+
 // NewTBN returns a new matrix made from the supplied tagent, bitangent and normal vectors.
 func NewTBN(tangent, bitangent, normal vec3.Vec3Impl) Mat3 {
 	return Mat3{
@@ -30,6 +43,28 @@ func NewTBN(tangent, bitangent, normal vec3.Vec3Impl) Mat3 {
 	}
 }
 
+// NewTBNFromNormal returns a new TBN matrix built around the supplied unit normal
+// using the tangent and bitangent computed by vec3.OrthonormalBasis.
+func NewTBNFromNormal(normal vec3.Vec3Impl) Mat3 {
+	tangent, bitangent := vec3.OrthonormalBasis(normal)
+	return NewTBN(tangent, bitangent, normal)
+}
+
+// LocalToWorld transforms a direction expressed in the local frame of the supplied orthonormal TBN matrix to world space.
+func LocalToWorld(tbn Mat3, v vec3.Vec3Impl) vec3.Vec3Impl {
+	return MatrixVectorMul(tbn, v)
+}
+
+// WorldToLocal transforms a world space direction to the local frame of the supplied orthonormal TBN matrix.
+// The inverse of an orthonormal matrix is its transpose, so no inversion is required.
+func WorldToLocal(tbn Mat3, v vec3.Vec3Impl) vec3.Vec3Impl {
+	return vec3.Vec3Impl{
+		X: tbn.A11*v.X + tbn.A21*v.Y + tbn.A31*v.Z,
+		Y: tbn.A12*v.X + tbn.A22*v.Y + tbn.A32*v.Z,
+		Z: tbn.A13*v.X + tbn.A23*v.Y + tbn.A33*v.Z,
+	}
+}
+
 // MatrixVectorMul returns the result of axv, where a is a matrix and v is a vector.
 func MatrixVectorMul(a Mat3, v vec3.Vec3Impl) vec3.Vec3Impl {
 	return vec3.Vec3Impl{
@@ -38,3 +73,103 @@ func MatrixVectorMul(a Mat3, v vec3.Vec3Impl) vec3.Vec3Impl {
 		Z: a.A31*v.X + a.A32*v.Y + a.A33*v.Z,
 	}
 }
+
+// FromAxisAngle returns a matrix that rotates theta radians around the supplied axis.
+// The axis does not need to be normalised. The argument order matches mat4.NewRotation.
+func FromAxisAngle(theta float32, axis vec3.Vec3Impl) Mat3 {
+	a := vec3.UnitVector(axis)
+	sinTheta := math32.Sin(theta)
+	cosTheta := math32.Cos(theta)
+	oneMinusCos := 1 - cosTheta
+
+	return Mat3{
+		A11: a.X*a.X*oneMinusCos + cosTheta,
+		A12: a.X*a.Y*oneMinusCos - a.Z*sinTheta,
+		A13: a.X*a.Z*oneMinusCos + a.Y*sinTheta,
+		A21: a.X*a.Y*oneMinusCos + a.Z*sinTheta,
+		A22: a.Y*a.Y*oneMinusCos + cosTheta,
+		A23: a.Y*a.Z*oneMinusCos - a.X*sinTheta,
+		A31: a.X*a.Z*oneMinusCos - a.Y*sinTheta,
+		A32: a.Y*a.Z*oneMinusCos + a.X*sinTheta,
+		A33: a.Z*a.Z*oneMinusCos + cosTheta,
+	}
+}
+
+// FromEuler returns a rotation matrix from the supplied Euler angles in radians.
+// The rotations are applied around the X axis first, then Y and finally Z, i.e. Rz x Ry x Rx.
+func FromEuler(x, y, z float32) Mat3 {
+	sx, cx := math32.Sin(x), math32.Cos(x)
+	sy, cy := math32.Sin(y), math32.Cos(y)
+	sz, cz := math32.Sin(z), math32.Cos(z)
+
+	return Mat3{
+		A11: cz * cy,
+		A12: cz*sy*sx - sz*cx,
+		A13: cz*sy*cx + sz*sx,
+		A21: sz * cy,
+		A22: sz*sy*sx + cz*cx,
+		A23: sz*sy*cx - cz*sx,
+		A31: -sy,
+		A32: cy * sx,
+		A33: cy * cx,
+	}
+}
+
+// MatrixMul returns the result of axb.
+func MatrixMul(a, b Mat3) Mat3 {
+	return Mat3{
+		A11: a.A11*b.A11 + a.A12*b.A21 + a.A13*b.A31,
+		A12: a.A11*b.A12 + a.A12*b.A22 + a.A13*b.A32,
+		A13: a.A11*b.A13 + a.A12*b.A23 + a.A13*b.A33,
+		A21: a.A21*b.A11 + a.A22*b.A21 + a.A23*b.A31,
+		A22: a.A21*b.A12 + a.A22*b.A22 + a.A23*b.A32,
+		A23: a.A21*b.A13 + a.A22*b.A23 + a.A23*b.A33,
+		A31: a.A31*b.A11 + a.A32*b.A21 + a.A33*b.A31,
+		A32: a.A31*b.A12 + a.A32*b.A22 + a.A33*b.A32,
+		A33: a.A31*b.A13 + a.A32*b.A23 + a.A33*b.A33,
+	}
+}
+
+// Transpose returns the transpose of the supplied matrix.
+// For an orthonormal matrix such as a TBN this is also its inverse.
+func Transpose(a Mat3) Mat3 {
+	return Mat3{
+		A11: a.A11, A12: a.A21, A13: a.A31,
+		A21: a.A12, A22: a.A22, A23: a.A32,
+		A31: a.A13, A32: a.A23, A33: a.A33,
+	}
+}
+
+// Determinant returns the determinant of the supplied matrix.
+func Determinant(a Mat3) float32 {
+	return a.A11*(a.A22*a.A33-a.A23*a.A32) -
+		a.A12*(a.A21*a.A33-a.A23*a.A31) +
+		a.A13*(a.A21*a.A32-a.A22*a.A31)
+}
+
+// Inverse returns the inverse of the supplied matrix.
+// The boolean result is false if the matrix is singular, in which case the returned matrix is the zero matrix.
+func Inverse(a Mat3) (Mat3, bool) {
+	c11 := a.A22*a.A33 - a.A23*a.A32
+	c12 := a.A23*a.A31 - a.A21*a.A33
+	c13 := a.A21*a.A32 - a.A22*a.A31
+
+	det := a.A11*c11 + a.A12*c12 + a.A13*c13
+	if det == 0 || math32.IsNaN(det) || math32.IsInf(det, 0) {
+		return Mat3{}, false
+	}
+
+	invDet := 1 / det
+
+	return Mat3{
+		A11: c11 * invDet,
+		A12: (a.A13*a.A32 - a.A12*a.A33) * invDet,
+		A13: (a.A12*a.A23 - a.A13*a.A22) * invDet,
+		A21: c12 * invDet,
+		A22: (a.A11*a.A33 - a.A13*a.A31) * invDet,
+		A23: (a.A13*a.A21 - a.A11*a.A23) * invDet,
+		A31: c13 * invDet,
+		A32: (a.A12*a.A31 - a.A11*a.A32) * invDet,
+		A33: (a.A11*a.A22 - a.A12*a.A21) * invDet,
+	}, true
+}
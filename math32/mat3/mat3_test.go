@@ -1,10 +1,12 @@
 package mat3
 
 import (
+	"math"
 	"testing"
 
 	"github.com/flynn-nrg/go-vfx/math32/vec3"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestMatrixVectorMul(t *testing.T) {
@@ -54,3 +56,255 @@ func TestMatrixVectorMul(t *testing.T) {
 	}
 
 }
+
+// toFloat64 returns the supplied matrix as a row-major float64 array.
+func toFloat64(a Mat3) [3][3]float64 {
+	return [3][3]float64{
+		{float64(a.A11), float64(a.A12), float64(a.A13)},
+		{float64(a.A21), float64(a.A22), float64(a.A23)},
+		{float64(a.A31), float64(a.A32), float64(a.A33)},
+	}
+}
+
+// matrixMul64 is the float64 reference matrix multiplication.
+func matrixMul64(a, b [3][3]float64) [3][3]float64 {
+	var res [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				res[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return res
+}
+
+// rotation64 is the float64 reference rotation of theta radians around a unit axis.
+func rotation64(x, y, z, theta float64) [3][3]float64 {
+	s, c := math.Sin(theta), math.Cos(theta)
+	return [3][3]float64{
+		{x*x*(1-c) + c, x*y*(1-c) - z*s, x*z*(1-c) + y*s},
+		{x*y*(1-c) + z*s, y*y*(1-c) + c, y*z*(1-c) - x*s},
+		{x*z*(1-c) - y*s, y*z*(1-c) + x*s, z*z*(1-c) + c},
+	}
+}
+
+func assertClose(t *testing.T, name string, got Mat3, want [3][3]float64, tolerance float64) {
+	t.Helper()
+	g := toFloat64(got)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if diff := math.Abs(g[i][j] - want[i][j]); diff > tolerance {
+				t.Errorf("%s: element [%d][%d] = %v, want %v (diff: %e)", name, i+1, j+1, g[i][j], want[i][j], diff)
+			}
+		}
+	}
+}
+
+var testMatrices = []struct {
+	name   string
+	matrix Mat3
+}{
+	{
+		name:   "Identity",
+		matrix: Identity(),
+	},
+	{
+		name: "General",
+		matrix: Mat3{
+			A11: 2, A12: 0.5, A13: -1,
+			A21: 0.25, A22: 1.5, A23: 0.75,
+			A31: -0.5, A32: 1, A33: 3,
+		},
+	},
+	{
+		name:   "TBN",
+		matrix: NewTBN(vec3.Vec3Impl{X: -1}, vec3.Vec3Impl{Z: 1}, vec3.Vec3Impl{Y: 1}),
+	},
+	{
+		name:   "Axis angle rotation",
+		matrix: FromAxisAngle(1.1, vec3.Vec3Impl{X: 1, Y: 2, Z: 3}),
+	},
+}
+
+func TestMatrixMul(t *testing.T) {
+	for _, a := range testMatrices {
+		for _, b := range testMatrices {
+			t.Run(a.name+" x "+b.name, func(t *testing.T) {
+				got := MatrixMul(a.matrix, b.matrix)
+				want := matrixMul64(toFloat64(a.matrix), toFloat64(b.matrix))
+				assertClose(t, "MatrixMul()", got, want, 1e-5)
+			})
+		}
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	for _, test := range testMatrices {
+		t.Run(test.name, func(t *testing.T) {
+			got := Transpose(test.matrix)
+			a := toFloat64(test.matrix)
+			var want [3][3]float64
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 3; j++ {
+					want[i][j] = a[j][i]
+				}
+			}
+			assertClose(t, "Transpose()", got, want, 0)
+		})
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	for _, test := range testMatrices {
+		t.Run(test.name, func(t *testing.T) {
+			a := toFloat64(test.matrix)
+			want := a[0][0]*(a[1][1]*a[2][2]-a[1][2]*a[2][1]) -
+				a[0][1]*(a[1][0]*a[2][2]-a[1][2]*a[2][0]) +
+				a[0][2]*(a[1][0]*a[2][1]-a[1][1]*a[2][0])
+			got := float64(Determinant(test.matrix))
+			if diff := math.Abs(got - want); diff > 1e-5 {
+				t.Errorf("Determinant() = %v, want %v (diff: %e)", got, want, diff)
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	identity := toFloat64(Identity())
+	for _, test := range testMatrices {
+		t.Run(test.name, func(t *testing.T) {
+			inv, ok := Inverse(test.matrix)
+			if !ok {
+				t.Fatalf("Inverse() reported a singular matrix")
+			}
+			got := matrixMul64(toFloat64(test.matrix), toFloat64(inv))
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 3; j++ {
+					if diff := math.Abs(got[i][j] - identity[i][j]); diff > 1e-6 {
+						t.Errorf("axinv(a) element [%d][%d] = %v, want %v", i+1, j+1, got[i][j], identity[i][j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestInverseSingular(t *testing.T) {
+	testData := []struct {
+		name   string
+		matrix Mat3
+	}{
+		{
+			name: "Zero matrix",
+		},
+		{
+			name: "Linearly dependent rows",
+			matrix: Mat3{
+				A11: 1, A12: 2, A13: 3,
+				A21: 2, A22: 4, A23: 6,
+				A31: 0, A32: 1, A33: 0,
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := Inverse(test.matrix)
+			if ok {
+				t.Errorf("Inverse() = %v, want singular", got)
+			}
+		})
+	}
+}
+
+func TestTBNTransposeIsInverse(t *testing.T) {
+	// Transforming to world space and back with the transpose must yield the original tangent space vector.
+	tbn := NewTBN(vec3.Vec3Impl{Z: 1}, vec3.Vec3Impl{Y: 1}, vec3.Vec3Impl{X: -1})
+	v := vec3.Vec3Impl{X: 0.2, Y: -0.3, Z: 0.9}
+
+	world := MatrixVectorMul(tbn, v)
+	got := MatrixVectorMul(Transpose(tbn), world)
+
+	if diff := cmp.Diff(v, got); diff != "" {
+		t.Errorf("MatrixVectorMul() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFromAxisAngle(t *testing.T) {
+	testData := []struct {
+		name  string
+		axis  vec3.Vec3Impl
+		theta float32
+	}{
+		{
+			name:  "X axis",
+			axis:  vec3.Vec3Impl{X: 1},
+			theta: 0.5,
+		},
+		{
+			name:  "Unnormalised Y axis",
+			axis:  vec3.Vec3Impl{Y: 3},
+			theta: -1.2,
+		},
+		{
+			name:  "Arbitrary axis",
+			axis:  vec3.Vec3Impl{X: 1, Y: 2, Z: 3},
+			theta: 2.5,
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			a := vec3.UnitVector(test.axis)
+			want := rotation64(float64(a.X), float64(a.Y), float64(a.Z), float64(test.theta))
+			got := FromAxisAngle(test.theta, test.axis)
+			assertClose(t, "FromAxisAngle()", got, want, 1e-6)
+		})
+	}
+}
+
+func TestFromEuler(t *testing.T) {
+	x, y, z := 0.3, -0.7, 1.9
+	want := matrixMul64(rotation64(0, 0, 1, z), matrixMul64(rotation64(0, 1, 0, y), rotation64(1, 0, 0, x)))
+	got := FromEuler(float32(x), float32(y), float32(z))
+	assertClose(t, "FromEuler()", got, want, 1e-6)
+
+	// A single rotation around Z maps X onto Y.
+	v := MatrixVectorMul(FromEuler(0, 0, math.Pi/2), vec3.Vec3Impl{X: 1})
+	if diff := cmp.Diff(vec3.Vec3Impl{Y: 1}, v, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+		t.Errorf("MatrixVectorMul() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewTBNFromNormal(t *testing.T) {
+	approx := cmpopts.EquateApprox(0, 1e-6)
+
+	normals := []vec3.Vec3Impl{
+		{Z: 1},
+		{Z: -1},
+		{X: 1},
+		vec3.UnitVector(vec3.Vec3Impl{X: 1, Y: -2, Z: 0.5}),
+		vec3.UnitVector(vec3.Vec3Impl{X: 1e-4, Y: 1e-4, Z: -1}),
+	}
+
+	for _, normal := range normals {
+		tbn := NewTBNFromNormal(normal)
+
+		// The local Z axis maps onto the normal.
+		if diff := cmp.Diff(normal, LocalToWorld(tbn, vec3.Vec3Impl{Z: 1}), approx); diff != "" {
+			t.Errorf("LocalToWorld() mismatch (-want +got):\n%s", diff)
+		}
+
+		// The normal maps onto the local Z axis.
+		if diff := cmp.Diff(vec3.Vec3Impl{Z: 1}, WorldToLocal(tbn, normal), approx); diff != "" {
+			t.Errorf("WorldToLocal() mismatch (-want +got):\n%s", diff)
+		}
+
+		// Round trip an arbitrary direction.
+		v := vec3.Vec3Impl{X: 0.3, Y: -0.4, Z: 0.866}
+		if diff := cmp.Diff(v, WorldToLocal(tbn, LocalToWorld(tbn, v)), approx); diff != "" {
+			t.Errorf("WorldToLocal(LocalToWorld()) mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Package mat4 implements functions to work with 4x4 matrices and affine transforms.
+package mat4
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// Mat4 represents a 4x4 matrix in row-major order.
+// Vectors are treated as columns, so transforms are applied as axv.
+type Mat4 struct {
+	A11 float32
+	A12 float32
+	A13 float32
+	A14 float32
+	A21 float32
+	A22 float32
+	A23 float32
+	A24 float32
+	A31 float32
+	A32 float32
+	A33 float32
+	A34 float32
+	A41 float32
+	A42 float32
+	A43 float32
+	A44 float32
+}
+
+// Identity returns the 4x4 identity matrix.
+func Identity() Mat4 {
+	return Mat4{
+		A11: 1,
+		A22: 1,
+		A33: 1,
+		A44: 1,
+	}
+}
+
+// NewTranslation returns a matrix that translates points by the supplied vector.
+func NewTranslation(delta vec3.Vec3Impl) Mat4 {
+	return Mat4{
+		A11: 1,
+		A14: delta.X,
+		A22: 1,
+		A24: delta.Y,
+		A33: 1,
+		A34: delta.Z,
+		A44: 1,
+	}
+}
+
+// NewScale returns a matrix that scales each axis by the matching component of the supplied vector.
+func NewScale(scale vec3.Vec3Impl) Mat4 {
+	return Mat4{
+		A11: scale.X,
+		A22: scale.Y,
+		A33: scale.Z,
+		A44: 1,
+	}
+}
+
+// NewRotationX returns a matrix that rotates theta radians around the X axis.
+func NewRotationX(theta float32) Mat4 {
+	sinTheta := math32.Sin(theta)
+	cosTheta := math32.Cos(theta)
+
+	return Mat4{
+		A11: 1,
+		A22: cosTheta,
+		A23: -sinTheta,
+		A32: sinTheta,
+		A33: cosTheta,
+		A44: 1,
+	}
+}
+
+// NewRotationY returns a matrix that rotates theta radians around the Y axis.
+func NewRotationY(theta float32) Mat4 {
+	sinTheta := math32.Sin(theta)
+	cosTheta := math32.Cos(theta)
+
+	return Mat4{
+		A11: cosTheta,
+		A13: sinTheta,
+		A22: 1,
+		A31: -sinTheta,
+		A33: cosTheta,
+		A44: 1,
+	}
+}
+
+// NewRotationZ returns a matrix that rotates theta radians around the Z axis.
+func NewRotationZ(theta float32) Mat4 {
+	sinTheta := math32.Sin(theta)
+	cosTheta := math32.Cos(theta)
+
+	return Mat4{
+		A11: cosTheta,
+		A12: -sinTheta,
+		A21: sinTheta,
+		A22: cosTheta,
+		A33: 1,
+		A44: 1,
+	}
+}
+
+// NewRotation returns a matrix that rotates theta radians around the supplied axis.
+// The axis does not need to be normalised.
+func NewRotation(theta float32, axis vec3.Vec3Impl) Mat4 {
+	a := vec3.UnitVector(axis)
+	sinTheta := math32.Sin(theta)
+	cosTheta := math32.Cos(theta)
+	oneMinusCos := 1 - cosTheta
+
+	return Mat4{
+		A11: a.X*a.X*oneMinusCos + cosTheta,
+		A12: a.X*a.Y*oneMinusCos - a.Z*sinTheta,
+		A13: a.X*a.Z*oneMinusCos + a.Y*sinTheta,
+		A21: a.X*a.Y*oneMinusCos + a.Z*sinTheta,
+		A22: a.Y*a.Y*oneMinusCos + cosTheta,
+		A23: a.Y*a.Z*oneMinusCos - a.X*sinTheta,
+		A31: a.X*a.Z*oneMinusCos - a.Y*sinTheta,
+		A32: a.Y*a.Z*oneMinusCos + a.X*sinTheta,
+		A33: a.Z*a.Z*oneMinusCos + cosTheta,
+		A44: 1,
+	}
+}
+
+// NewLookAt returns the world to camera matrix of a camera placed at eye looking at center.
+// The camera looks down its negative Z axis with up as the approximate Y axis.
+func NewLookAt(eye, center, up vec3.Vec3Impl) Mat4 {
+	forward := vec3.UnitVector(vec3.Sub(center, eye))
+	right := vec3.UnitVector(vec3.Cross(forward, up))
+	newUp := vec3.Cross(right, forward)
+
+	return Mat4{
+		A11: right.X,
+		A12: right.Y,
+		A13: right.Z,
+		A14: -vec3.Dot(right, eye),
+		A21: newUp.X,
+		A22: newUp.Y,
+		A23: newUp.Z,
+		A24: -vec3.Dot(newUp, eye),
+		A31: -forward.X,
+		A32: -forward.Y,
+		A33: -forward.Z,
+		A34: vec3.Dot(forward, eye),
+		A44: 1,
+	}
+}
+
+// NewPerspective returns a perspective projection matrix with the given vertical field of view in radians.
+// Points in camera space between the near and far planes are mapped to a Z range of [-1, 1]
+// after the perspective divide performed by TransformPoint.
+func NewPerspective(fovY, aspect, near, far float32) Mat4 {
+	f := 1 / math32.Tan(fovY/2)
+
+	return Mat4{
+		A11: f / aspect,
+		A22: f,
+		A33: (far + near) / (near - far),
+		A34: (2 * far * near) / (near - far),
+		A43: -1,
+	}
+}
+
+// MatrixMul returns the result of axb.
+func MatrixMul(a, b Mat4) Mat4 {
+	return Mat4{
+		A11: a.A11*b.A11 + a.A12*b.A21 + a.A13*b.A31 + a.A14*b.A41,
+		A12: a.A11*b.A12 + a.A12*b.A22 + a.A13*b.A32 + a.A14*b.A42,
+		A13: a.A11*b.A13 + a.A12*b.A23 + a.A13*b.A33 + a.A14*b.A43,
+		A14: a.A11*b.A14 + a.A12*b.A24 + a.A13*b.A34 + a.A14*b.A44,
+		A21: a.A21*b.A11 + a.A22*b.A21 + a.A23*b.A31 + a.A24*b.A41,
+		A22: a.A21*b.A12 + a.A22*b.A22 + a.A23*b.A32 + a.A24*b.A42,
+		A23: a.A21*b.A13 + a.A22*b.A23 + a.A23*b.A33 + a.A24*b.A43,
+		A24: a.A21*b.A14 + a.A22*b.A24 + a.A23*b.A34 + a.A24*b.A44,
+		A31: a.A31*b.A11 + a.A32*b.A21 + a.A33*b.A31 + a.A34*b.A41,
+		A32: a.A31*b.A12 + a.A32*b.A22 + a.A33*b.A32 + a.A34*b.A42,
+		A33: a.A31*b.A13 + a.A32*b.A23 + a.A33*b.A33 + a.A34*b.A43,
+		A34: a.A31*b.A14 + a.A32*b.A24 + a.A33*b.A34 + a.A34*b.A44,
+		A41: a.A41*b.A11 + a.A42*b.A21 + a.A43*b.A31 + a.A44*b.A41,
+		A42: a.A41*b.A12 + a.A42*b.A22 + a.A43*b.A32 + a.A44*b.A42,
+		A43: a.A41*b.A13 + a.A42*b.A23 + a.A43*b.A33 + a.A44*b.A43,
+		A44: a.A41*b.A14 + a.A42*b.A24 + a.A43*b.A34 + a.A44*b.A44,
+	}
+}
+
+// Transpose returns the transpose of the supplied matrix.
+func Transpose(a Mat4) Mat4 {
+	return Mat4{
+		A11: a.A11, A12: a.A21, A13: a.A31, A14: a.A41,
+		A21: a.A12, A22: a.A22, A23: a.A32, A24: a.A42,
+		A31: a.A13, A32: a.A23, A33: a.A33, A34: a.A43,
+		A41: a.A14, A42: a.A24, A43: a.A34, A44: a.A44,
+	}
+}
+
+// Determinant returns the determinant of the supplied matrix.
+func Determinant(a Mat4) float32 {
+	// Expand along the first row using the 2x2 minors of the bottom two rows.
+	s0 := a.A31*a.A42 - a.A32*a.A41
+	s1 := a.A31*a.A43 - a.A33*a.A41
+	s2 := a.A31*a.A44 - a.A34*a.A41
+	s3 := a.A32*a.A43 - a.A33*a.A42
+	s4 := a.A32*a.A44 - a.A34*a.A42
+	s5 := a.A33*a.A44 - a.A34*a.A43
+
+	c11 := a.A22*s5 - a.A23*s4 + a.A24*s3
+	c12 := a.A21*s5 - a.A23*s2 + a.A24*s1
+	c13 := a.A21*s4 - a.A22*s2 + a.A24*s0
+	c14 := a.A21*s3 - a.A22*s1 + a.A23*s0
+
+	return a.A11*c11 - a.A12*c12 + a.A13*c13 - a.A14*c14
+}
+
+// Inverse returns the inverse of the supplied matrix.
+// The boolean result is false if the matrix is singular, in which case the returned matrix is the zero matrix.
+func Inverse(a Mat4) (Mat4, bool) {
+	// 2x2 minors of the top two rows.
+	s0 := a.A11*a.A22 - a.A21*a.A12
+	s1 := a.A11*a.A23 - a.A21*a.A13
+	s2 := a.A11*a.A24 - a.A21*a.A14
+	s3 := a.A12*a.A23 - a.A22*a.A13
+	s4 := a.A12*a.A24 - a.A22*a.A14
+	s5 := a.A13*a.A24 - a.A23*a.A14
+
+	// 2x2 minors of the bottom two rows.
+	c5 := a.A33*a.A44 - a.A43*a.A34
+	c4 := a.A32*a.A44 - a.A42*a.A34
+	c3 := a.A32*a.A43 - a.A42*a.A33
+	c2 := a.A31*a.A44 - a.A41*a.A34
+	c1 := a.A31*a.A43 - a.A41*a.A33
+	c0 := a.A31*a.A42 - a.A41*a.A32
+
+	det := s0*c5 - s1*c4 + s2*c3 + s3*c2 - s4*c1 + s5*c0
+	if det == 0 || math32.IsNaN(det) || math32.IsInf(det, 0) {
+		return Mat4{}, false
+	}
+
+	invDet := 1 / det
+
+	return Mat4{
+		A11: (a.A22*c5 - a.A23*c4 + a.A24*c3) * invDet,
+		A12: (-a.A12*c5 + a.A13*c4 - a.A14*c3) * invDet,
+		A13: (a.A42*s5 - a.A43*s4 + a.A44*s3) * invDet,
+		A14: (-a.A32*s5 + a.A33*s4 - a.A34*s3) * invDet,
+		A21: (-a.A21*c5 + a.A23*c2 - a.A24*c1) * invDet,
+		A22: (a.A11*c5 - a.A13*c2 + a.A14*c1) * invDet,
+		A23: (-a.A41*s5 + a.A43*s2 - a.A44*s1) * invDet,
+		A24: (a.A31*s5 - a.A33*s2 + a.A34*s1) * invDet,
+		A31: (a.A21*c4 - a.A22*c2 + a.A24*c0) * invDet,
+		A32: (-a.A11*c4 + a.A12*c2 - a.A14*c0) * invDet,
+		A33: (a.A41*s4 - a.A42*s2 + a.A44*s0) * invDet,
+		A34: (-a.A31*s4 + a.A32*s2 - a.A34*s0) * invDet,
+		A41: (-a.A21*c3 + a.A22*c1 - a.A23*c0) * invDet,
+		A42: (a.A11*c3 - a.A12*c1 + a.A13*c0) * invDet,
+		A43: (-a.A41*s3 + a.A42*s1 - a.A43*s0) * invDet,
+		A44: (a.A31*s3 - a.A32*s1 + a.A33*s0) * invDet,
+	}, true
+}
+
+// TransformPoint returns the result of applying a to the point p.
+// The result is divided by the homogeneous coordinate when it is not 1,
+// which makes this function suitable for projective transforms too.
+func TransformPoint(a Mat4, p vec3.Vec3Impl) vec3.Vec3Impl {
+	x := a.A11*p.X + a.A12*p.Y + a.A13*p.Z + a.A14
+	y := a.A21*p.X + a.A22*p.Y + a.A23*p.Z + a.A24
+	z := a.A31*p.X + a.A32*p.Y + a.A33*p.Z + a.A34
+	w := a.A41*p.X + a.A42*p.Y + a.A43*p.Z + a.A44
+
+	if w == 1 {
+		return vec3.Vec3Impl{X: x, Y: y, Z: z}
+	}
+
+	return vec3.Vec3Impl{X: x / w, Y: y / w, Z: z / w}
+}
+
+// TransformVector returns the result of applying a to the direction v.
+// Translation is ignored as directions have a homogeneous coordinate of 0.
+func TransformVector(a Mat4, v vec3.Vec3Impl) vec3.Vec3Impl {
+	return vec3.Vec3Impl{
+		X: a.A11*v.X + a.A12*v.Y + a.A13*v.Z,
+		Y: a.A21*v.X + a.A22*v.Y + a.A23*v.Z,
+		Z: a.A31*v.X + a.A32*v.Y + a.A33*v.Z,
+	}
+}
+
+// TransformNormal returns the surface normal n transformed by the matrix whose inverse is inv.
+// Normals transform by the inverse transpose, so callers that already keep the inverse
+// of a transform around can use it directly without computing it again.
+// The result is not normalised.
+func TransformNormal(inv Mat4, n vec3.Vec3Impl) vec3.Vec3Impl {
+	return vec3.Vec3Impl{
+		X: inv.A11*n.X + inv.A21*n.Y + inv.A31*n.Z,
+		Y: inv.A12*n.X + inv.A22*n.Y + inv.A32*n.Z,
+		Z: inv.A13*n.X + inv.A23*n.Y + inv.A33*n.Z,
+	}
+}
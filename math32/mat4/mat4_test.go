@@ -0,0 +1,311 @@
+package mat4
+
+import (
+	"math"
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// toFloat64 returns the supplied matrix as a row-major float64 array.
+func toFloat64(a Mat4) [4][4]float64 {
+	return [4][4]float64{
+		{float64(a.A11), float64(a.A12), float64(a.A13), float64(a.A14)},
+		{float64(a.A21), float64(a.A22), float64(a.A23), float64(a.A24)},
+		{float64(a.A31), float64(a.A32), float64(a.A33), float64(a.A34)},
+		{float64(a.A41), float64(a.A42), float64(a.A43), float64(a.A44)},
+	}
+}
+
+// matrixMul64 is the float64 reference matrix multiplication.
+func matrixMul64(a, b [4][4]float64) [4][4]float64 {
+	var res [4][4]float64
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			for k := 0; k < 4; k++ {
+				res[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return res
+}
+
+// determinant64 is the float64 reference determinant computed by cofactor expansion.
+func determinant64(a [4][4]float64) float64 {
+	var det float64
+	for j := 0; j < 4; j++ {
+		var minor [3][3]float64
+		for r := 1; r < 4; r++ {
+			c := 0
+			for k := 0; k < 4; k++ {
+				if k == j {
+					continue
+				}
+				minor[r-1][c] = a[r][k]
+				c++
+			}
+		}
+		m := minor[0][0]*(minor[1][1]*minor[2][2]-minor[1][2]*minor[2][1]) -
+			minor[0][1]*(minor[1][0]*minor[2][2]-minor[1][2]*minor[2][0]) +
+			minor[0][2]*(minor[1][0]*minor[2][1]-minor[1][1]*minor[2][0])
+		sign := 1.0
+		if j%2 == 1 {
+			sign = -1.0
+		}
+		det += sign * a[0][j] * m
+	}
+	return det
+}
+
+func assertClose(t *testing.T, name string, got Mat4, want [4][4]float64, tolerance float64) {
+	t.Helper()
+	g := toFloat64(got)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if diff := math.Abs(g[i][j] - want[i][j]); diff > tolerance {
+				t.Errorf("%s: element [%d][%d] = %v, want %v (diff: %e)", name, i+1, j+1, g[i][j], want[i][j], diff)
+			}
+		}
+	}
+}
+
+var testMatrices = []struct {
+	name   string
+	matrix Mat4
+}{
+	{
+		name:   "Identity",
+		matrix: Identity(),
+	},
+	{
+		name: "General affine",
+		matrix: Mat4{
+			A11: 2, A12: 0.5, A13: -1, A14: 3,
+			A21: 0.25, A22: 1.5, A23: 0.75, A24: -2,
+			A31: -0.5, A32: 1, A33: 3, A34: 0.5,
+			A44: 1,
+		},
+	},
+	{
+		name: "General projective",
+		matrix: Mat4{
+			A11: 4, A12: 7, A13: 2, A14: 3,
+			A21: 1, A22: 5, A23: 6, A24: 2,
+			A31: 8, A32: 3, A33: 9, A34: 1,
+			A41: 2, A42: 4, A43: 1, A44: 7,
+		},
+	},
+	{
+		name: "Rotation composed with translation",
+		matrix: MatrixMul(
+			NewTranslation(vec3.Vec3Impl{X: 1, Y: -2, Z: 3}),
+			NewRotation(0.7, vec3.Vec3Impl{X: 1, Y: 1, Z: 0}),
+		),
+	},
+}
+
+func TestMatrixMul(t *testing.T) {
+	for _, a := range testMatrices {
+		for _, b := range testMatrices {
+			t.Run(a.name+" x "+b.name, func(t *testing.T) {
+				got := MatrixMul(a.matrix, b.matrix)
+				want := matrixMul64(toFloat64(a.matrix), toFloat64(b.matrix))
+				assertClose(t, "MatrixMul()", got, want, 1e-4)
+			})
+		}
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	for _, test := range testMatrices {
+		t.Run(test.name, func(t *testing.T) {
+			got := Transpose(test.matrix)
+			a := toFloat64(test.matrix)
+			var want [4][4]float64
+			for i := 0; i < 4; i++ {
+				for j := 0; j < 4; j++ {
+					want[i][j] = a[j][i]
+				}
+			}
+			assertClose(t, "Transpose()", got, want, 0)
+		})
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	for _, test := range testMatrices {
+		t.Run(test.name, func(t *testing.T) {
+			got := float64(Determinant(test.matrix))
+			want := determinant64(toFloat64(test.matrix))
+			if diff := math.Abs(got - want); diff > 1e-4*math.Max(1, math.Abs(want)) {
+				t.Errorf("Determinant() = %v, want %v (diff: %e)", got, want, diff)
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	identity := toFloat64(Identity())
+	for _, test := range testMatrices {
+		t.Run(test.name, func(t *testing.T) {
+			inv, ok := Inverse(test.matrix)
+			if !ok {
+				t.Fatalf("Inverse() reported a singular matrix")
+			}
+			got := matrixMul64(toFloat64(test.matrix), toFloat64(inv))
+			for i := 0; i < 4; i++ {
+				for j := 0; j < 4; j++ {
+					if diff := math.Abs(got[i][j] - identity[i][j]); diff > 1e-5 {
+						t.Errorf("axinv(a) element [%d][%d] = %v, want %v", i+1, j+1, got[i][j], identity[i][j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestInverseSingular(t *testing.T) {
+	testData := []struct {
+		name   string
+		matrix Mat4
+	}{
+		{
+			name: "Zero matrix",
+		},
+		{
+			name:   "Zero scale",
+			matrix: NewScale(vec3.Vec3Impl{X: 1, Y: 0, Z: 1}),
+		},
+		{
+			name: "Linearly dependent rows",
+			matrix: Mat4{
+				A11: 1, A12: 2, A13: 3, A14: 4,
+				A21: 2, A22: 4, A23: 6, A24: 8,
+				A31: 0, A32: 1, A33: 0, A34: 1,
+				A41: 0, A42: 0, A43: 0, A44: 1,
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := Inverse(test.matrix)
+			if ok {
+				t.Errorf("Inverse() = %v, want singular", got)
+			}
+		})
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	approx := cmpopts.EquateApprox(0, 1e-6)
+
+	testData := []struct {
+		name      string
+		transform func(Mat4, vec3.Vec3Impl) vec3.Vec3Impl
+		matrix    Mat4
+		vector    vec3.Vec3Impl
+		want      vec3.Vec3Impl
+	}{
+		{
+			name:      "Translate point",
+			transform: TransformPoint,
+			matrix:    NewTranslation(vec3.Vec3Impl{X: 1, Y: 2, Z: 3}),
+			vector:    vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+			want:      vec3.Vec3Impl{X: 2, Y: 3, Z: 4},
+		},
+		{
+			name:      "Translation does not affect vectors",
+			transform: TransformVector,
+			matrix:    NewTranslation(vec3.Vec3Impl{X: 1, Y: 2, Z: 3}),
+			vector:    vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+			want:      vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+		},
+		{
+			name:      "Scale point",
+			transform: TransformPoint,
+			matrix:    NewScale(vec3.Vec3Impl{X: 2, Y: 3, Z: 4}),
+			vector:    vec3.Vec3Impl{X: 1, Y: 1, Z: 1},
+			want:      vec3.Vec3Impl{X: 2, Y: 3, Z: 4},
+		},
+		{
+			name:      "Rotate X axis 90 degrees around Z",
+			transform: TransformVector,
+			matrix:    NewRotationZ(math.Pi / 2),
+			vector:    vec3.Vec3Impl{X: 1},
+			want:      vec3.Vec3Impl{Y: 1},
+		},
+		{
+			name:      "Rotate Y axis 90 degrees around X",
+			transform: TransformVector,
+			matrix:    NewRotationX(math.Pi / 2),
+			vector:    vec3.Vec3Impl{Y: 1},
+			want:      vec3.Vec3Impl{Z: 1},
+		},
+		{
+			name:      "Rotate Z axis 90 degrees around Y",
+			transform: TransformVector,
+			matrix:    NewRotationY(math.Pi / 2),
+			vector:    vec3.Vec3Impl{Z: 1},
+			want:      vec3.Vec3Impl{X: 1},
+		},
+		{
+			name:      "Arbitrary axis rotation matches axis rotation",
+			transform: TransformVector,
+			matrix:    NewRotation(math.Pi/2, vec3.Vec3Impl{Z: 5}),
+			vector:    vec3.Vec3Impl{X: 1},
+			want:      vec3.Vec3Impl{Y: 1},
+		},
+		{
+			name:      "Look at moves the target onto the negative Z axis",
+			transform: TransformPoint,
+			matrix:    NewLookAt(vec3.Vec3Impl{X: 0, Y: 0, Z: 5}, vec3.Vec3Impl{}, vec3.Vec3Impl{Y: 1}),
+			vector:    vec3.Vec3Impl{},
+			want:      vec3.Vec3Impl{Z: -5},
+		},
+		{
+			name:      "Perspective maps the near plane to -1",
+			transform: TransformPoint,
+			matrix:    NewPerspective(math.Pi/2, 1, 1, 100),
+			vector:    vec3.Vec3Impl{Z: -1},
+			want:      vec3.Vec3Impl{Z: -1},
+		},
+		{
+			name:      "Perspective maps the far plane to 1",
+			transform: TransformPoint,
+			matrix:    NewPerspective(math.Pi/2, 1, 1, 100),
+			vector:    vec3.Vec3Impl{X: 100, Z: -100},
+			want:      vec3.Vec3Impl{X: 1, Z: 1},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.transform(test.matrix, test.vector)
+			if diff := cmp.Diff(test.want, got, approx); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransformNormal(t *testing.T) {
+	// A non-uniform scale must keep normals perpendicular to the surface.
+	m := NewScale(vec3.Vec3Impl{X: 4, Y: 1, Z: 1})
+	inv, ok := Inverse(m)
+	if !ok {
+		t.Fatalf("Inverse() reported a singular matrix")
+	}
+
+	tangent := vec3.Vec3Impl{X: 1, Y: -1}
+	normal := vec3.Vec3Impl{X: 1, Y: 1}
+
+	transformedTangent := TransformVector(m, tangent)
+	transformedNormal := TransformNormal(inv, normal)
+
+	if dot := vec3.Dot(transformedTangent, transformedNormal); math.Abs(float64(dot)) > 1e-6 {
+		t.Errorf("transformed normal is not perpendicular to the transformed tangent: dot = %v", dot)
+	}
+}
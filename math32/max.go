@@ -4,14 +4,19 @@ package math32
 //
 // Special cases are:
 //
-//	Max(x, +Inf) = Max(+Inf, x) = +Inf
 //	Max(x, NaN) = Max(NaN, x) = NaN
+//	Max(x, +Inf) = Max(+Inf, x) = +Inf
+//	Max(+0, ±0) = Max(±0, +0) = +0
+//	Max(-0, -0) = -0
 //
-// Note: The sign of zero (±0) is hardware-dependent and may vary.
+// A NaN argument is returned unchanged, x if both are NaN.
 func Max(x, y float32) float32 {
-	// Handle NaN - if either is NaN, return NaN
-	if IsNaN(x) || IsNaN(y) {
-		return NaN()
+	// Handle NaN - return the NaN argument so that its payload is preserved
+	if IsNaN(x) {
+		return x
+	}
+	if IsNaN(y) {
+		return y
 	}
 
 	// Handle infinities
@@ -23,8 +28,6 @@ func Max(x, y float32) float32 {
 	}
 
 	// Use hardware instruction via assembly
-	// Note: Signed zero behavior (+0 vs -0) is hardware-dependent
-	// and doesn't matter for VFX/graphics applications
 	return max(x, y)
 }
 
@@ -32,14 +35,19 @@ func Max(x, y float32) float32 {
 //
 // Special cases are:
 //
-//	Min(x, -Inf) = Min(-Inf, x) = -Inf
 //	Min(x, NaN) = Min(NaN, x) = NaN
+//	Min(x, -Inf) = Min(-Inf, x) = -Inf
+//	Min(-0, ±0) = Min(±0, -0) = -0
+//	Min(+0, +0) = +0
 //
-// Note: The sign of zero (±0) is hardware-dependent and may vary.
+// A NaN argument is returned unchanged, x if both are NaN.
 func Min(x, y float32) float32 {
-	// Handle NaN - if either is NaN, return NaN
-	if IsNaN(x) || IsNaN(y) {
-		return NaN()
+	// Handle NaN - return the NaN argument so that its payload is preserved
+	if IsNaN(x) {
+		return x
+	}
+	if IsNaN(y) {
+		return y
 	}
 
 	// Handle infinities
@@ -51,7 +59,5 @@ func Min(x, y float32) float32 {
 	}
 
 	// Use hardware instruction via assembly
-	// Note: Signed zero behavior (+0 vs -0) is hardware-dependent
-	// and doesn't matter for VFX/graphics applications
 	return min(x, y)
 }
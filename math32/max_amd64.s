@@ -1,22 +1,30 @@
-//go:build amd64
+//go:build amd64 && !purego
 
 #include "textflag.h"
 
+// MAXSS and MINSS return their second operand when the operands compare equal, so
+// the sign of a zero result would depend on the argument order. Computing both
+// orders and combining them with AND (max) or OR (min) gives +0 and -0 respectively,
+// and leaves every other result unchanged.
+
 // func max(x, y float32) float32
 TEXT ·max(SB),NOSPLIT,$0-12
-	// ABI wrapper stores inputs at 8(SP) and 12(SP), expects output at 16(SP)
-	MOVSS	8(SP), X0        // Load x
-	MOVSS	12(SP), X1       // Load y
-	MAXSS	X1, X0           // X0 = max(X0, X1)
-	MOVSS	X0, 16(SP)       // Store result
+	MOVSS	x+0(FP), X0      // Load x
+	MOVSS	y+4(FP), X1      // Load y
+	MOVSS	X0, X2
+	MAXSS	X1, X2           // X2 = max(x, y)
+	MAXSS	X0, X1           // X1 = max(y, x)
+	ANDPS	X2, X1           // Max(-0, +0) = +0
+	MOVSS	X1, ret+8(FP)    // Store result
 	RET
 
 // func min(x, y float32) float32
 TEXT ·min(SB),NOSPLIT,$0-12
-	// ABI wrapper stores inputs at 8(SP) and 12(SP), expects output at 16(SP)
-	MOVSS	8(SP), X0        // Load x
-	MOVSS	12(SP), X1       // Load y
-	MINSS	X1, X0           // X0 = min(X0, X1)
-	MOVSS	X0, 16(SP)       // Store result
+	MOVSS	x+0(FP), X0      // Load x
+	MOVSS	y+4(FP), X1      // Load y
+	MOVSS	X0, X2
+	MINSS	X1, X2           // X2 = min(x, y)
+	MINSS	X0, X1           // X1 = min(y, x)
+	ORPS	X2, X1           // Min(-0, +0) = -0
+	MOVSS	X1, ret+8(FP)    // Store result
 	RET
-
@@ -1,18 +1,22 @@
-//go:build !amd64 && !arm64
+//go:build (!amd64 && !arm64) || purego
 
 package math32
 
-// max provides a software fallback for architectures without assembly implementation.
+// max provides a software fallback for architectures without assembly implementation
+// and for purego builds.
+// x and y are not NaN. +0 is considered larger than -0.
 func max(x, y float32) float32 {
-	if x > y {
+	if x > y || x == y && !Signbit(x) {
 		return x
 	}
 	return y
 }
 
-// min provides a software fallback for architectures without assembly implementation.
+// min provides a software fallback for architectures without assembly implementation
+// and for purego builds.
+// x and y are not NaN. -0 is considered smaller than +0.
 func min(x, y float32) float32 {
-	if x < y {
+	if x < y || x == y && Signbit(x) {
 		return x
 	}
 	return y
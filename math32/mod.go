@@ -0,0 +1,109 @@
+package math32
+
+// Mod returns the floating-point remainder of x/y.
+// The magnitude of the result is less than y and its
+// sign agrees with that of x. The result is exact.
+//
+// Special cases are:
+//
+//	Mod(±Inf, y) = NaN
+//	Mod(NaN, y) = NaN
+//	Mod(x, 0) = NaN
+//	Mod(x, ±Inf) = x
+//	Mod(x, NaN) = NaN
+func Mod(x, y float32) float32 {
+	// Handle special cases
+	if y == 0 || IsInf(x, 0) || IsNaN(x) || IsNaN(y) {
+		return NaN()
+	}
+
+	y = Abs(y)
+
+	yfr, yexp := Frexp(y)
+	r := x
+	if x < 0 {
+		r = -x
+	}
+
+	// Subtract the largest y·2^n not exceeding r until r < y; every step is exact.
+	for r >= y {
+		rfr, rexp := Frexp(r)
+		if rfr < yfr {
+			rexp = rexp - 1
+		}
+		r = r - Ldexp(y, rexp-yexp)
+	}
+
+	if x < 0 {
+		r = -r
+	}
+	return r
+}
+
+// Remainder returns the IEEE 754 floating-point remainder of x/y,
+// x - n·y where n is the integer nearest to x/y, ties to even.
+//
+// Special cases are:
+//
+//	Remainder(±Inf, y) = NaN
+//	Remainder(NaN, y) = NaN
+//	Remainder(x, 0) = NaN
+//	Remainder(x, ±Inf) = x
+//	Remainder(x, NaN) = NaN
+func Remainder(x, y float32) float32 {
+	const (
+		tiny    = 0x1p-125 // 2 * smallest normal
+		halfMax = MaxFloat32 / 2
+	)
+
+	// Handle special cases
+	if IsNaN(x) || IsNaN(y) || IsInf(x, 0) || y == 0 {
+		return NaN()
+	}
+
+	if IsInf(y, 0) {
+		return x
+	}
+
+	sign := false
+	if x < 0 {
+		x = -x
+		sign = true
+	}
+	if y < 0 {
+		y = -y
+	}
+
+	if x == y {
+		if sign {
+			return Copysign(0, -1)
+		}
+		return 0
+	}
+
+	if y <= halfMax {
+		x = Mod(x, y+y) // now x < 2y
+	}
+
+	if y < tiny {
+		if x+x > y {
+			x -= y
+			if x+x >= y {
+				x -= y
+			}
+		}
+	} else {
+		yHalf := 0.5 * y
+		if x > yHalf {
+			x -= y
+			if x >= yHalf {
+				x -= y
+			}
+		}
+	}
+
+	if sign {
+		x = -x
+	}
+	return x
+}
@@ -0,0 +1,98 @@
+package math32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		name     string
+		x, y     float32
+		expected float32
+	}{
+		// Special cases
+		{"Inf dividend", Inf(1), 1, NaN()},
+		{"NaN dividend", NaN(), 1, NaN()},
+		{"zero divisor", 1, 0, NaN()},
+		{"Inf divisor", 3, Inf(-1), 3},
+		{"NaN divisor", 1, NaN(), NaN()},
+		{"negative zero", Copysign(0, -1), 2, Copysign(0, -1)},
+
+		// Sign follows the dividend
+		{"5 mod 3", 5, 3, 2},
+		{"-5 mod 3", -5, 3, -2},
+		{"5 mod -3", 5, -3, 2},
+		{"-6 mod 3", -6, 3, Copysign(0, -1)},
+		{"5.5 mod 2", 5.5, 2, 1.5},
+
+		// Exact for very different magnitudes
+		{"2^100 mod 3", 0x1p100, 3, 1},
+		{"denormal divisor", 1, 0x1p-140, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mod(tt.x, tt.y); !identical(got, tt.expected) {
+				t.Errorf("Mod(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRemainder(t *testing.T) {
+	tests := []struct {
+		name     string
+		x, y     float32
+		expected float32
+	}{
+		// Special cases
+		{"Inf dividend", Inf(-1), 1, NaN()},
+		{"NaN dividend", NaN(), 1, NaN()},
+		{"zero divisor", 1, 0, NaN()},
+		{"Inf divisor", 3, Inf(1), 3},
+		{"NaN divisor", 1, NaN(), NaN()},
+		{"equal", -2, 2, Copysign(0, -1)},
+
+		// Nearest multiple, ties to even
+		{"5 rem 3", 5, 3, -1},
+		{"4 rem 3", 4, 3, 1},
+		{"3 rem 2", 3, 2, -1},
+		{"5 rem 2", 5, 2, 1},
+		{"-5 rem 2", -5, 2, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Remainder(tt.x, tt.y); !identical(got, tt.expected) {
+				t.Errorf("Remainder(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestModRemainderExact(t *testing.T) {
+	// Both functions are exact, so they must match the float64 versions bit for bit.
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		x := math.Float32frombits(random.Uint32())
+		y := math.Float32frombits(random.Uint32())
+
+		if got, want := Mod(x, y), float32(math.Mod(float64(x), float64(y))); !identical(got, want) {
+			t.Fatalf("Mod(%v, %v) = %v, want %v", x, y, got, want)
+		}
+		if got, want := Remainder(x, y), float32(math.Remainder(float64(x), float64(y))); !identical(got, want) {
+			t.Fatalf("Remainder(%v, %v) = %v, want %v", x, y, got, want)
+		}
+	}
+}
+
+func BenchmarkMod(b *testing.B) {
+	x, y := float32(1e6), float32(3.7)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Mod(x, y)
+	}
+	_ = result
+}
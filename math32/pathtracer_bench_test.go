@@ -5,17 +5,21 @@ import (
 	"testing"
 
 	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/bvh"
 	"github.com/flynn-nrg/go-vfx/math32/fastrandom"
+	"github.com/flynn-nrg/go-vfx/math32/geom"
 	"github.com/flynn-nrg/go-vfx/math32/mat3"
 	"github.com/flynn-nrg/go-vfx/math32/vec3"
 )
 
-// Float32 implementation using math32 package
+// The scene is a glass sphere centred at the origin. Rays leaving its surface start
+// rayEpsilon away from it so that they do not hit it again at the same point.
+const (
+	sphereRadius = 2
+	rayEpsilon   = 1e-3
+)
 
-type Ray32 struct {
-	Origin    vec3.Vec3Impl
-	Direction vec3.Vec3Impl
-}
+// Float32 implementation using math32 package
 
 // reflect32 reflects a vector v around normal n
 func reflect32(v, n vec3.Vec3Impl) vec3.Vec3Impl {
@@ -80,19 +84,107 @@ func scatterDielectric32(rayDir, normal vec3.Vec3Impl, refIdx float32, random *f
 	return refracted, true
 }
 
+// scatterDielectric32Optics is scatterDielectric32 written with the vec3 optics helpers
+func scatterDielectric32Optics(rayDir, normal vec3.Vec3Impl, refIdx float32, random *fastrandom.XorShift) (vec3.Vec3Impl, bool) {
+	var outwardNormal vec3.Vec3Impl
+	var niOverNt float32
+	var cosine float32
+
+	dotDN := vec3.Dot(rayDir, normal)
+
+	if dotDN > 0 {
+		outwardNormal = vec3.ScalarMul(normal, -1)
+		niOverNt = refIdx
+		cosine = refIdx * dotDN / vec3.UnitVector(rayDir).Length()
+	} else {
+		outwardNormal = normal
+		niOverNt = 1.0 / refIdx
+		cosine = -dotDN / vec3.UnitVector(rayDir).Length()
+	}
+
+	var reflectProb float32
+	refracted, canRefract := vec3.Refract(rayDir, outwardNormal, niOverNt)
+
+	if canRefract {
+		reflectProb = vec3.Schlick(cosine, refIdx)
+	} else {
+		reflectProb = 1.0
+	}
+
+	if random.Float32() < reflectProb {
+		return vec3.Reflect(rayDir, normal), true
+	}
+
+	return refracted, true
+}
+
+// reflect32Methods is reflect32 written with the inlinable Vec3Impl methods
+func reflect32Methods(v, n vec3.Vec3Impl) vec3.Vec3Impl {
+	return v.Sub(n.Scale(2 * v.Dot(n)))
+}
+
+// refract32Methods is refract32 written with the inlinable Vec3Impl methods
+func refract32Methods(v, n vec3.Vec3Impl, niOverNt float32) (vec3.Vec3Impl, bool) {
+	uv := vec3.UnitVector(v)
+	dt := uv.Dot(n)
+	discriminant := 1.0 - niOverNt*niOverNt*(1-dt*dt)
+	if discriminant > 0 {
+		refracted := uv.Sub(n.Scale(dt)).Scale(niOverNt)
+		refracted.SubAssign(n.Scale(math32.Sqrt(discriminant)))
+		return refracted, true
+	}
+	return vec3.Vec3Impl{}, false
+}
+
+// scatterDielectric32Methods is scatterDielectric32 written with the inlinable Vec3Impl methods
+func scatterDielectric32Methods(rayDir, normal vec3.Vec3Impl, refIdx float32, random *fastrandom.XorShift) (vec3.Vec3Impl, bool) {
+	var outwardNormal vec3.Vec3Impl
+	var niOverNt float32
+	var cosine float32
+
+	dotDN := rayDir.Dot(normal)
+
+	if dotDN > 0 {
+		outwardNormal = normal.Neg()
+		niOverNt = refIdx
+		cosine = refIdx * dotDN / vec3.UnitVector(rayDir).Length()
+	} else {
+		outwardNormal = normal
+		niOverNt = 1.0 / refIdx
+		cosine = -dotDN / vec3.UnitVector(rayDir).Length()
+	}
+
+	var reflectProb float32
+	refracted, canRefract := refract32Methods(rayDir, outwardNormal, niOverNt)
+
+	if canRefract {
+		reflectProb = schlick32(cosine, refIdx)
+	} else {
+		reflectProb = 1.0
+	}
+
+	if random.Float32() < reflectProb {
+		return reflect32Methods(rayDir, normal), true
+	}
+
+	return refracted, true
+}
+
 // pathTrace32 simulates a complete path tracing pass with multiple bounces
-func pathTrace32(ray *Ray32, maxDepth int, random *fastrandom.XorShift) vec3.Vec3Impl {
+func pathTrace32(ray geom.Ray, maxDepth int, random *fastrandom.XorShift) vec3.Vec3Impl {
 	attenuation := vec3.Vec3Impl{X: 1.0, Y: 1.0, Z: 1.0}
 	currentRay := ray
+	center := vec3.Vec3Impl{X: 0, Y: 0, Z: 0}
 
 	for depth := 0; depth < maxDepth; depth++ {
-		// Simulate ray-sphere intersection
-		// Use some arbitrary values to simulate hitting a dielectric sphere
-		t := 1.5 + random.Float32()*0.5
-		hitPoint := vec3.Add(currentRay.Origin, vec3.ScalarMul(currentRay.Direction, t))
+		// Intersect the ray with the dielectric sphere
+		t, hit := geom.IntersectSphere(currentRay, center, sphereRadius)
+		if !hit {
+			break
+		}
+		hitPoint := currentRay.PointAt(t)
 
 		// Calculate normal (for a sphere centered at origin)
-		center := vec3.Vec3Impl{X: 0, Y: 0, Z: 0}
 		normal := vec3.UnitVector(vec3.Sub(hitPoint, center))
 
 		// Scatter through dielectric material
@@ -121,12 +213,14 @@ func pathTrace32(ray *Ray32, maxDepth int, random *fastrandom.XorShift) vec3.Vec
 		scattered = mat3.MatrixVectorMul(tbn, scattered)
 		scattered = vec3.UnitVector(scattered)
 
-		// Prepare for next bounce
-		currentRay = &Ray32{
-			Origin:    hitPoint,
-			Direction: scattered,
+		// Keep the path inside the sphere so that every bounce hits it again
+		if vec3.Dot(scattered, normal) > 0 {
+			scattered = vec3.ScalarMul(scattered, -1)
 		}
 
+		// Prepare for next bounce
+		currentRay = geom.NewRay(hitPoint, scattered, rayEpsilon, math32.Inf(1))
+
 		// Russian roulette for path termination
 		survivalProb := float32(0.9)
 		if random.Float32() > survivalProb {
@@ -145,6 +239,8 @@ func pathTrace32(ray *Ray32, maxDepth int, random *fastrandom.XorShift) vec3.Vec
 type Ray64 struct {
 	Origin    [3]float64
 	Direction [3]float64
+	TMin      float64
+	TMax      float64
 }
 
 func dot64(v1, v2 [3]float64) float64 {
@@ -197,6 +293,42 @@ func refract64(v, n [3]float64, niOverNt float64) ([3]float64, bool) {
 	return [3]float64{}, false
 }
 
+// intersectSphere64 is geom.IntersectSphere in float64
+func intersectSphere64(r *Ray64, center [3]float64, radius float64) (float64, bool) {
+	f := sub64(r.Origin, center)
+	a := dot64(r.Direction, r.Direction)
+	bPrime := dot64(f, r.Direction)
+	c := dot64(f, f) - radius*radius
+
+	l := sub64(f, scalarMul64(r.Direction, bPrime/a))
+	discriminant := a * (radius*radius - dot64(l, l))
+	if !(discriminant >= 0) {
+		return 0, false
+	}
+
+	q := -(bPrime + math.Copysign(math.Sqrt(discriminant), bPrime))
+
+	var t0, t1 float64
+	if q != 0 {
+		t0 = c / q
+		t1 = q / a
+	}
+
+	if t0 > t1 {
+		t0, t1 = t1, t0
+	}
+
+	if t0 > r.TMin && t0 < r.TMax {
+		return t0, true
+	}
+
+	if t1 > r.TMin && t1 < r.TMax {
+		return t1, true
+	}
+
+	return 0, false
+}
+
 func schlick64(cosine, refIdx float64) float64 {
 	r0 := (1 - refIdx) / (1 + refIdx)
 	r0 = r0 * r0
@@ -248,14 +380,17 @@ func matrixVectorMul64(m [9]float64, v [3]float64) [3]float64 {
 func pathTrace64(ray *Ray64, maxDepth int, random *fastrandom.XorShift) [3]float64 {
 	attenuation := [3]float64{1.0, 1.0, 1.0}
 	currentRay := ray
+	center := [3]float64{0, 0, 0}
 
 	for depth := 0; depth < maxDepth; depth++ {
-		// Simulate ray-sphere intersection
-		t := 1.5 + float64(random.Float32())*0.5
+		// Intersect the ray with the dielectric sphere
+		t, hit := intersectSphere64(currentRay, center, sphereRadius)
+		if !hit {
+			break
+		}
 		hitPoint := add64(currentRay.Origin, scalarMul64(currentRay.Direction, t))
 
 		// Calculate normal
-		center := [3]float64{0, 0, 0}
 		normal := normalize64(sub64(hitPoint, center))
 
 		// Scatter through dielectric material
@@ -287,9 +422,16 @@ func pathTrace64(ray *Ray64, maxDepth int, random *fastrandom.XorShift) [3]float
 		scattered = matrixVectorMul64(tbn, scattered)
 		scattered = normalize64(scattered)
 
+		// Keep the path inside the sphere
+		if dot64(scattered, normal) > 0 {
+			scattered = scalarMul64(scattered, -1)
+		}
+
 		currentRay = &Ray64{
 			Origin:    hitPoint,
 			Direction: scattered,
+			TMin:      rayEpsilon,
+			TMax:      math.Inf(1),
 		}
 
 		// Russian roulette
@@ -304,14 +446,68 @@ func pathTrace64(ray *Ray64, maxDepth int, random *fastrandom.XorShift) [3]float
 	return result
 }
 
+// Scene intersection with and without a BVH
+
+type sceneTriangle struct {
+	v0, v1, v2 vec3.Vec3Impl
+}
+
+func (tri *sceneTriangle) Bounds() geom.AABB {
+	return geom.NewAABBFromTriangle(tri.v0, tri.v1, tri.v2)
+}
+
+func (tri *sceneTriangle) Intersect(r geom.Ray) (float32, bool) {
+	hit, ok := geom.IntersectTriangleWatertight(r, tri.v0, tri.v1, tri.v2)
+	return hit.T, ok
+}
+
+func randomScenePoint(random *fastrandom.XorShift, scale float32) vec3.Vec3Impl {
+	return vec3.Vec3Impl{
+		X: (random.Float32() - 0.5) * scale,
+		Y: (random.Float32() - 0.5) * scale,
+		Z: (random.Float32() - 0.5) * scale,
+	}
+}
+
+// randomScene returns n small triangles scattered through a cube and rays crossing it
+func randomScene(n int, random *fastrandom.XorShift) ([]bvh.Primitive, []geom.Ray) {
+	prims := make([]bvh.Primitive, n)
+	for i := range prims {
+		c := randomScenePoint(random, 100)
+		prims[i] = &sceneTriangle{
+			v0: vec3.Add(c, randomScenePoint(random, 2)),
+			v1: vec3.Add(c, randomScenePoint(random, 2)),
+			v2: vec3.Add(c, randomScenePoint(random, 2)),
+		}
+	}
+
+	rays := make([]geom.Ray, 1024)
+	for i := range rays {
+		rays[i] = geom.NewInfiniteRay(randomScenePoint(random, 150), vec3.UnitVector(randomScenePoint(random, 2)))
+	}
+	return prims, rays
+}
+
+// intersectLinear returns the closest hit by testing every primitive
+func intersectLinear(prims []bvh.Primitive, r geom.Ray) (bvh.Primitive, float32, bool) {
+	var closest bvh.Primitive
+	for _, p := range prims {
+		if t, ok := p.Intersect(r); ok {
+			r.TMax = t
+			closest = p
+		}
+	}
+	if closest == nil {
+		return nil, 0, false
+	}
+	return closest, r.TMax, true
+}
+
 // Benchmarks
 
 func BenchmarkPathTracer32_SingleBounce(b *testing.B) {
 	random := fastrandom.New(12345)
-	ray := &Ray32{
-		Origin:    vec3.Vec3Impl{X: 0, Y: 0, Z: -5},
-		Direction: vec3.Vec3Impl{X: 0, Y: 0, Z: 1},
-	}
+	ray := geom.NewInfiniteRay(vec3.Vec3Impl{X: 0, Y: 0, Z: -5}, vec3.Vec3Impl{X: 0, Y: 0, Z: 1})
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -327,6 +523,7 @@ func BenchmarkPathTracer64_SingleBounce(b *testing.B) {
 	ray := &Ray64{
 		Origin:    [3]float64{0, 0, -5},
 		Direction: [3]float64{0, 0, 1},
+		TMax:      math.Inf(1),
 	}
 
 	b.ResetTimer()
@@ -340,10 +537,7 @@ func BenchmarkPathTracer64_SingleBounce(b *testing.B) {
 
 func BenchmarkPathTracer32_FiveBounces(b *testing.B) {
 	random := fastrandom.New(12345)
-	ray := &Ray32{
-		Origin:    vec3.Vec3Impl{X: 0, Y: 0, Z: -5},
-		Direction: vec3.Vec3Impl{X: 0, Y: 0, Z: 1},
-	}
+	ray := geom.NewInfiniteRay(vec3.Vec3Impl{X: 0, Y: 0, Z: -5}, vec3.Vec3Impl{X: 0, Y: 0, Z: 1})
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -359,6 +553,7 @@ func BenchmarkPathTracer64_FiveBounces(b *testing.B) {
 	ray := &Ray64{
 		Origin:    [3]float64{0, 0, -5},
 		Direction: [3]float64{0, 0, 1},
+		TMax:      math.Inf(1),
 	}
 
 	b.ResetTimer()
@@ -372,10 +567,7 @@ func BenchmarkPathTracer64_FiveBounces(b *testing.B) {
 
 func BenchmarkPathTracer32_TenBounces(b *testing.B) {
 	random := fastrandom.New(12345)
-	ray := &Ray32{
-		Origin:    vec3.Vec3Impl{X: 0, Y: 0, Z: -5},
-		Direction: vec3.Vec3Impl{X: 0, Y: 0, Z: 1},
-	}
+	ray := geom.NewInfiniteRay(vec3.Vec3Impl{X: 0, Y: 0, Z: -5}, vec3.Vec3Impl{X: 0, Y: 0, Z: 1})
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -391,6 +583,7 @@ func BenchmarkPathTracer64_TenBounces(b *testing.B) {
 	ray := &Ray64{
 		Origin:    [3]float64{0, 0, -5},
 		Direction: [3]float64{0, 0, 1},
+		TMax:      math.Inf(1),
 	}
 
 	b.ResetTimer()
@@ -402,6 +595,56 @@ func BenchmarkPathTracer64_TenBounces(b *testing.B) {
 	}
 }
 
+func BenchmarkSceneLinear_1KTriangles(b *testing.B) {
+	prims, rays := randomScene(1000, fastrandom.New(12345))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, t, _ := intersectLinear(prims, rays[i%len(rays)])
+		if t < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
+func BenchmarkSceneBVH_1KTriangles(b *testing.B) {
+	prims, rays := randomScene(1000, fastrandom.New(12345))
+	scene := bvh.New(prims)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, t, _ := scene.Intersect(rays[i%len(rays)])
+		if t < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
+func BenchmarkSceneLinear_10KTriangles(b *testing.B) {
+	prims, rays := randomScene(10000, fastrandom.New(12345))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, t, _ := intersectLinear(prims, rays[i%len(rays)])
+		if t < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
+func BenchmarkSceneBVH_10KTriangles(b *testing.B) {
+	prims, rays := randomScene(10000, fastrandom.New(12345))
+	scene := bvh.New(prims)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, t, _ := scene.Intersect(rays[i%len(rays)])
+		if t < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
 // Individual operation benchmarks for detailed profiling
 
 func BenchmarkDielectricScatter32(b *testing.B) {
@@ -419,6 +662,36 @@ func BenchmarkDielectricScatter32(b *testing.B) {
 	}
 }
 
+func BenchmarkDielectricScatter32Methods(b *testing.B) {
+	random := fastrandom.New(12345)
+	rayDir := vec3.Vec3Impl{X: 0.5, Y: 0.5, Z: 1}
+	normal := vec3.Vec3Impl{X: 0, Y: 0, Z: -1}
+	refIdx := float32(1.5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result, ok := scatterDielectric32Methods(rayDir, normal, refIdx, random)
+		if !ok || result.X < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
+func BenchmarkDielectricScatter32Optics(b *testing.B) {
+	random := fastrandom.New(12345)
+	rayDir := vec3.Vec3Impl{X: 0.5, Y: 0.5, Z: 1}
+	normal := vec3.Vec3Impl{X: 0, Y: 0, Z: -1}
+	refIdx := float32(1.5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result, ok := scatterDielectric32Optics(rayDir, normal, refIdx, random)
+		if !ok || result.X < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
 func BenchmarkDielectricScatter64(b *testing.B) {
 	random := fastrandom.New(12345)
 	rayDir := [3]float64{0.5, 0.5, 1}
@@ -451,6 +724,23 @@ func BenchmarkVectorOps32(b *testing.B) {
 	}
 }
 
+func BenchmarkVectorOps32Methods(b *testing.B) {
+	v1 := vec3.Vec3Impl{X: 1, Y: 2, Z: 3}
+	v2 := vec3.Vec3Impl{X: 4, Y: 5, Z: 6}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r1 := v1.Add(v2)
+		r2 := v1.Sub(v2)
+		r3 := v1.Dot(v2)
+		r4 := v1.Cross(v2)
+		r5 := vec3.UnitVector(r1)
+		if r5.X+r2.X+r4.X+r3 < -1000 {
+			b.Fatal("unexpected")
+		}
+	}
+}
+
 func BenchmarkVectorOps64(b *testing.B) {
 	v1 := [3]float64{1, 2, 3}
 	v2 := [3]float64{4, 5, 6}
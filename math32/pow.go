@@ -2,6 +2,9 @@ package math32
 
 // Pow returns x**y, the base-x exponential of y.
 //
+// Pow computes Exp(y·Log(x)), which amplifies the error of the product, so the
+// error is at most 3 + 5·|y·ln x| ULP.
+//
 // Special cases are (in order):
 //
 //	Pow(x, ±0) = 1 for any x
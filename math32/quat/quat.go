@@ -0,0 +1,185 @@
+// Package quat implements functions to work with rotation quaternions.
+package quat
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// slerpThreshold is the cosine above which Slerp falls back to Nlerp
+// because the two rotations are too close for the sine ratio to be stable.
+const slerpThreshold = 0.9995
+
+// Quat represents a quaternion with imaginary part (X, Y, Z) and real part W.
+type Quat struct {
+	X float32
+	Y float32
+	Z float32
+	W float32
+}
+
+// Identity returns the quaternion representing no rotation.
+func Identity() Quat {
+	return Quat{W: 1}
+}
+
+// Length returns the length of this quaternion.
+func (q Quat) Length() float32 {
+	return math32.Sqrt(Dot(q, q))
+}
+
+// FromAxisAngle returns a unit quaternion that rotates theta radians around the supplied axis.
+// The axis does not need to be normalised. The argument order matches mat3.FromAxisAngle.
+func FromAxisAngle(theta float32, axis vec3.Vec3Impl) Quat {
+	a := vec3.UnitVector(axis)
+	s := math32.Sin(theta / 2)
+
+	return Quat{
+		X: a.X * s,
+		Y: a.Y * s,
+		Z: a.Z * s,
+		W: math32.Cos(theta / 2),
+	}
+}
+
+// FromMat3 returns the unit quaternion equivalent to the supplied rotation matrix.
+func FromMat3(m mat3.Mat3) Quat {
+	// Pick the largest diagonal term to keep the square root well away from zero.
+	trace := m.A11 + m.A22 + m.A33
+
+	var q Quat
+	switch {
+	case trace > 0:
+		s := 2 * math32.Sqrt(trace+1)
+		q = Quat{
+			X: (m.A32 - m.A23) / s,
+			Y: (m.A13 - m.A31) / s,
+			Z: (m.A21 - m.A12) / s,
+			W: s / 4,
+		}
+	case m.A11 > m.A22 && m.A11 > m.A33:
+		s := 2 * math32.Sqrt(1+m.A11-m.A22-m.A33)
+		q = Quat{
+			X: s / 4,
+			Y: (m.A12 + m.A21) / s,
+			Z: (m.A13 + m.A31) / s,
+			W: (m.A32 - m.A23) / s,
+		}
+	case m.A22 > m.A33:
+		s := 2 * math32.Sqrt(1+m.A22-m.A11-m.A33)
+		q = Quat{
+			X: (m.A12 + m.A21) / s,
+			Y: s / 4,
+			Z: (m.A23 + m.A32) / s,
+			W: (m.A13 - m.A31) / s,
+		}
+	default:
+		s := 2 * math32.Sqrt(1+m.A33-m.A11-m.A22)
+		q = Quat{
+			X: (m.A13 + m.A31) / s,
+			Y: (m.A23 + m.A32) / s,
+			Z: s / 4,
+			W: (m.A21 - m.A12) / s,
+		}
+	}
+
+	return Normalize(q)
+}
+
+// ToMat3 returns the rotation matrix equivalent to the supplied unit quaternion.
+func ToMat3(q Quat) mat3.Mat3 {
+	xx, yy, zz := q.X*q.X, q.Y*q.Y, q.Z*q.Z
+	xy, xz, yz := q.X*q.Y, q.X*q.Z, q.Y*q.Z
+	wx, wy, wz := q.W*q.X, q.W*q.Y, q.W*q.Z
+
+	return mat3.Mat3{
+		A11: 1 - 2*(yy+zz),
+		A12: 2 * (xy - wz),
+		A13: 2 * (xz + wy),
+		A21: 2 * (xy + wz),
+		A22: 1 - 2*(xx+zz),
+		A23: 2 * (yz - wx),
+		A31: 2 * (xz - wy),
+		A32: 2 * (yz + wx),
+		A33: 1 - 2*(xx+yy),
+	}
+}
+
+// Mul returns the Hamilton product axb, which applies rotation b first and then a.
+func Mul(a, b Quat) Quat {
+	return Quat{
+		X: a.W*b.X + a.X*b.W + a.Y*b.Z - a.Z*b.Y,
+		Y: a.W*b.Y - a.X*b.Z + a.Y*b.W + a.Z*b.X,
+		Z: a.W*b.Z + a.X*b.Y - a.Y*b.X + a.Z*b.W,
+		W: a.W*b.W - a.X*b.X - a.Y*b.Y - a.Z*b.Z,
+	}
+}
+
+// Conjugate returns the conjugate of the supplied quaternion.
+// For a unit quaternion this is the inverse rotation.
+func Conjugate(q Quat) Quat {
+	return Quat{X: -q.X, Y: -q.Y, Z: -q.Z, W: q.W}
+}
+
+// Dot computes the dot product of the two supplied quaternions.
+func Dot(a, b Quat) float32 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W*b.W
+}
+
+// Normalize returns a unit quaternion representation of the supplied quaternion.
+func Normalize(q Quat) Quat {
+	l := q.Length()
+
+	return Quat{X: q.X / l, Y: q.Y / l, Z: q.Z / l, W: q.W / l}
+}
+
+// Rotate returns the vector v rotated by the unit quaternion q.
+func Rotate(q Quat, v vec3.Vec3Impl) vec3.Vec3Impl {
+	// v' = v + 2w(u x v) + 2u x (u x v), where u is the imaginary part of q.
+	u := vec3.Vec3Impl{X: q.X, Y: q.Y, Z: q.Z}
+	t := vec3.ScalarMul(vec3.Cross(u, v), 2)
+
+	return vec3.Add(v, vec3.ScalarMul(t, q.W), vec3.Cross(u, t))
+}
+
+// Nlerp performs a normalised linear interpolation between the two supplied unit quaternions.
+// It always takes the shortest path but does not have constant angular velocity.
+func Nlerp(a, b Quat, t float32) Quat {
+	if Dot(a, b) < 0 {
+		b = Quat{X: -b.X, Y: -b.Y, Z: -b.Z, W: -b.W}
+	}
+
+	return Normalize(Quat{
+		X: (1-t)*a.X + t*b.X,
+		Y: (1-t)*a.Y + t*b.Y,
+		Z: (1-t)*a.Z + t*b.Z,
+		W: (1-t)*a.W + t*b.W,
+	})
+}
+
+// Slerp performs a spherical linear interpolation between the two supplied unit quaternions.
+// It always takes the shortest path and has constant angular velocity.
+func Slerp(a, b Quat, t float32) Quat {
+	cosTheta := Dot(a, b)
+	if cosTheta < 0 {
+		b = Quat{X: -b.X, Y: -b.Y, Z: -b.Z, W: -b.W}
+		cosTheta = -cosTheta
+	}
+
+	if cosTheta > slerpThreshold {
+		return Nlerp(a, b, t)
+	}
+
+	theta := math32.Acos(cosTheta)
+	sinTheta := math32.Sqrt(1 - cosTheta*cosTheta)
+	wa := math32.Sin((1-t)*theta) / sinTheta
+	wb := math32.Sin(t*theta) / sinTheta
+
+	return Quat{
+		X: wa*a.X + wb*b.X,
+		Y: wa*a.Y + wb*b.Y,
+		Z: wa*a.Z + wb*b.Z,
+		W: wa*a.W + wb*b.W,
+	}
+}
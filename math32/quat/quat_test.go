@@ -0,0 +1,167 @@
+package quat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/mat3"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestRotate(t *testing.T) {
+	approx := cmpopts.EquateApprox(0, 1e-6)
+
+	testData := []struct {
+		name   string
+		q      Quat
+		vector vec3.Vec3Impl
+		want   vec3.Vec3Impl
+	}{
+		{
+			name:   "Identity",
+			q:      Identity(),
+			vector: vec3.Vec3Impl{X: 1, Y: 2, Z: 3},
+			want:   vec3.Vec3Impl{X: 1, Y: 2, Z: 3},
+		},
+		{
+			name:   "90 degrees around Z",
+			q:      FromAxisAngle(math.Pi/2, vec3.Vec3Impl{Z: 1}),
+			vector: vec3.Vec3Impl{X: 1},
+			want:   vec3.Vec3Impl{Y: 1},
+		},
+		{
+			name:   "180 degrees around Y",
+			q:      FromAxisAngle(math.Pi, vec3.Vec3Impl{Y: 2}),
+			vector: vec3.Vec3Impl{X: 1, Y: 1},
+			want:   vec3.Vec3Impl{X: -1, Y: 1},
+		},
+		{
+			name:   "Conjugate undoes the rotation",
+			q:      Mul(Conjugate(FromAxisAngle(1, vec3.Vec3Impl{X: 1, Y: 1})), FromAxisAngle(1, vec3.Vec3Impl{X: 1, Y: 1})),
+			vector: vec3.Vec3Impl{X: 0.5, Y: -2, Z: 3},
+			want:   vec3.Vec3Impl{X: 0.5, Y: -2, Z: 3},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := Rotate(test.q, test.vector)
+			if diff := cmp.Diff(test.want, got, approx); diff != "" {
+				t.Errorf("Rotate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	// Composing two rotations must match applying them one after another.
+	a := FromAxisAngle(0.8, vec3.Vec3Impl{X: 1, Y: 2, Z: 3})
+	b := FromAxisAngle(2.1, vec3.Vec3Impl{X: -1, Z: 1})
+	v := vec3.Vec3Impl{X: 0.3, Y: 0.7, Z: -0.2}
+
+	want := Rotate(a, Rotate(b, v))
+	got := Rotate(Mul(a, b), v)
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+		t.Errorf("Rotate(Mul()) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMat3RoundTrip(t *testing.T) {
+	testData := []struct {
+		name  string
+		axis  vec3.Vec3Impl
+		theta float32
+	}{
+		{name: "Small angle", axis: vec3.Vec3Impl{X: 1, Y: 1, Z: 1}, theta: 0.1},
+		{name: "X dominant", axis: vec3.Vec3Impl{X: 1}, theta: 3},
+		{name: "Y dominant", axis: vec3.Vec3Impl{X: 0.1, Y: 1}, theta: 3},
+		{name: "Z dominant", axis: vec3.Vec3Impl{Y: 0.1, Z: 1}, theta: 3},
+		{name: "Negative angle", axis: vec3.Vec3Impl{X: 2, Y: -1, Z: 0.5}, theta: -2},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			m := mat3.FromAxisAngle(test.theta, test.axis)
+			q := FromMat3(m)
+			want := FromAxisAngle(test.theta, test.axis)
+
+			// q and -q represent the same rotation.
+			if Dot(q, want) < 0 {
+				q = Quat{X: -q.X, Y: -q.Y, Z: -q.Z, W: -q.W}
+			}
+			if diff := cmp.Diff(want, q, cmpopts.EquateApprox(0, 1e-5)); diff != "" {
+				t.Errorf("FromMat3() mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(m, ToMat3(q), cmpopts.EquateApprox(0, 1e-5)); diff != "" {
+				t.Errorf("ToMat3() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSlerp(t *testing.T) {
+	axis := vec3.Vec3Impl{X: 1, Y: -2, Z: 0.5}
+	a := FromAxisAngle(0.2, axis)
+	b := FromAxisAngle(1.8, axis)
+
+	for _, tt := range []float32{0, 0.25, 0.5, 0.75, 1} {
+		want := FromAxisAngle(0.2+tt*1.6, axis)
+		got := Slerp(a, b, tt)
+		if diff := cmp.Diff(want, got, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+			t.Errorf("Slerp(%v) mismatch (-want +got):\n%s", tt, diff)
+		}
+		if l := got.Length(); math.Abs(float64(l)-1) > 1e-6 {
+			t.Errorf("Slerp(%v) length = %v, want 1", tt, l)
+		}
+	}
+}
+
+func TestSlerpShortestPath(t *testing.T) {
+	a := FromAxisAngle(0.1, vec3.Vec3Impl{Z: 1})
+	b := FromAxisAngle(0.3, vec3.Vec3Impl{Z: 1})
+	negB := Quat{X: -b.X, Y: -b.Y, Z: -b.Z, W: -b.W}
+
+	want := FromAxisAngle(0.2, vec3.Vec3Impl{Z: 1})
+	for name, got := range map[string]Quat{
+		"Slerp": Slerp(a, negB, 0.5),
+		"Nlerp": Nlerp(a, negB, 0.5),
+	} {
+		if diff := cmp.Diff(want, got, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+			t.Errorf("%s() mismatch (-want +got):\n%s", name, diff)
+		}
+	}
+}
+
+func TestSlerpNearlyParallel(t *testing.T) {
+	a := FromAxisAngle(1, vec3.Vec3Impl{Y: 1})
+	b := FromAxisAngle(1.0001, vec3.Vec3Impl{Y: 1})
+
+	got := Slerp(a, b, 0.5)
+	if math.IsNaN(float64(got.W)) || math.Abs(float64(got.Length())-1) > 1e-6 {
+		t.Errorf("Slerp() = %v, want a unit quaternion", got)
+	}
+}
+
+func BenchmarkSlerp(b *testing.B) {
+	q0 := FromAxisAngle(0.2, vec3.Vec3Impl{X: 1, Y: 1})
+	q1 := FromAxisAngle(2.2, vec3.Vec3Impl{X: 1, Y: 1})
+	var result Quat
+	for i := 0; i < b.N; i++ {
+		result = Slerp(q0, q1, 0.3)
+	}
+	_ = result
+}
+
+func BenchmarkNlerp(b *testing.B) {
+	q0 := FromAxisAngle(0.2, vec3.Vec3Impl{X: 1, Y: 1})
+	q1 := FromAxisAngle(2.2, vec3.Vec3Impl{X: 1, Y: 1})
+	var result Quat
+	for i := 0; i < b.N; i++ {
+		result = Nlerp(q0, q1, 0.3)
+	}
+	_ = result
+}
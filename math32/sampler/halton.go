@@ -0,0 +1,152 @@
+package sampler
+
+import "github.com/flynn-nrg/go-vfx/math32/vec2"
+
+// maxHaltonDimensions is the number of prime bases used by the Halton sampler.
+// Requests beyond it wrap around to the first bases with a different index offset.
+const maxHaltonDimensions = 128
+
+// primes holds the first maxHaltonDimensions prime numbers.
+var primes = func() []uint32 {
+	p := make([]uint32, 0, maxHaltonDimensions)
+	for n := uint32(2); len(p) < maxHaltonDimensions; n++ {
+		isPrime := true
+		for _, q := range p {
+			if q*q > n {
+				break
+			}
+			if n%q == 0 {
+				isPrime = false
+				break
+			}
+		}
+		if isPrime {
+			p = append(p, n)
+		}
+	}
+	return p
+}()
+
+// digitPermutation holds one random permutation of the digits of a base per digit position.
+type digitPermutation struct {
+	base      uint32
+	numDigits int
+	// perms holds the permutation for digit position i at [i*base, (i+1)*base).
+	perms []uint16
+}
+
+// Halton generates samples from the Halton sequence with random digit permutations.
+// Each pixel starts at a different offset of the sequence so that neighbouring pixels
+// are decorrelated.
+type Halton struct {
+	perms     []digitPermutation
+	seed      uint32
+	offset    uint64
+	index     uint64
+	dimension int
+}
+
+// NewHalton returns a new Halton sampler whose digit permutations are derived from seed.
+func NewHalton(seed uint32) *Halton {
+	h := &Halton{
+		perms: make([]digitPermutation, maxHaltonDimensions),
+		seed:  seed,
+	}
+
+	for d, base := range primes {
+		h.perms[d] = newDigitPermutation(base, hash(0, 0, d, seed))
+	}
+
+	return h
+}
+
+// StartPixelSample prepares the sampler to generate the sample with the given index for pixel (x, y).
+func (h *Halton) StartPixelSample(x, y int, index int) {
+	h.offset = hash(x, y, -1, h.seed) >> 32
+	h.index = uint64(index)
+	h.dimension = 0
+}
+
+// Get1D returns the next dimension of the current sample in [0, 1).
+func (h *Halton) Get1D() float32 {
+	v := h.sample(h.dimension)
+	h.dimension++
+
+	return v
+}
+
+// Get2D returns the next two dimensions of the current sample in [0, 1)^2.
+func (h *Halton) Get2D() vec2.Vec2Impl {
+	v := vec2.Vec2Impl{X: h.sample(h.dimension), Y: h.sample(h.dimension + 1)}
+	h.dimension += 2
+
+	return v
+}
+
+// sample returns the given dimension of the current sample.
+func (h *Halton) sample(dimension int) float32 {
+	index := h.offset + h.index
+	if dimension >= maxHaltonDimensions {
+		index += hash(0, 0, dimension/maxHaltonDimensions, h.seed) >> 32
+	}
+
+	return permutedRadicalInverse(index, &h.perms[dimension%maxHaltonDimensions])
+}
+
+// newDigitPermutation returns random permutations for every digit of base that
+// contributes to a float32 radical inverse.
+func newDigitPermutation(base uint32, seed uint64) digitPermutation {
+	invBase := 1 / float32(base)
+	invBaseM := float32(1)
+	numDigits := 0
+	for 1-float32(base-1)*invBaseM < 1 {
+		invBaseM *= invBase
+		numDigits++
+	}
+
+	dp := digitPermutation{
+		base:      base,
+		numDigits: numDigits,
+		perms:     make([]uint16, numDigits*int(base)),
+	}
+
+	state := seed
+	for i := 0; i < numDigits; i++ {
+		perm := dp.perms[i*int(base) : (i+1)*int(base)]
+		for j := range perm {
+			perm[j] = uint16(j)
+		}
+		// Fisher-Yates shuffle driven by a SplitMix64 style counter.
+		for j := len(perm) - 1; j > 0; j-- {
+			state += 0x9e3779b97f4a7c15
+			k := mixBits(state) % uint64(j+1)
+			perm[j], perm[k] = perm[k], perm[j]
+		}
+	}
+
+	return dp
+}
+
+// permutedRadicalInverse mirrors the base-b digits of a around the radix point after
+// permuting each of them. Trailing zero digits are permuted as well, since they map
+// to non-zero digits in general.
+func permutedRadicalInverse(a uint64, dp *digitPermutation) float32 {
+	base := uint64(dp.base)
+	invBase := 1 / float64(dp.base)
+	invBaseM := float64(1)
+	var reversedDigits uint64
+	for i := 0; i < dp.numDigits; i++ {
+		next := a / base
+		digit := a - next*base
+		reversedDigits = reversedDigits*base + uint64(dp.perms[i*int(base)+int(digit)])
+		invBaseM *= invBase
+		a = next
+	}
+
+	v := float32(float64(reversedDigits) * invBaseM)
+	if v > oneMinusEpsilon {
+		return oneMinusEpsilon
+	}
+
+	return v
+}
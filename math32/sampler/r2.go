@@ -0,0 +1,56 @@
+package sampler
+
+import "github.com/flynn-nrg/go-vfx/math32/vec2"
+
+// Additive recurrence constants in 0.32 fixed point, see Roberts,
+// "The Unreasonable Effectiveness of Quasirandom Sequences", 2018.
+const (
+	// r1Alpha is 1/phi, where phi is the golden ratio.
+	r1Alpha = 0x9e3779b9
+	// r2AlphaX and r2AlphaY are 1/g and 1/g^2, where g is the plastic number.
+	r2AlphaX = 0xc13fa9a9
+	r2AlphaY = 0x91e10da6
+)
+
+// R2 generates samples from Roberts' R1 and R2 additive recurrence sequences.
+// Every dimension gets an independent toroidal shift per pixel.
+// The sequences are extremely cheap to evaluate and work for any sample count.
+type R2 struct {
+	seed      uint32
+	x, y      int
+	index     uint32
+	dimension int
+}
+
+// NewR2 returns a new R2 sampler.
+func NewR2(seed uint32) *R2 {
+	return &R2{seed: seed}
+}
+
+// StartPixelSample prepares the sampler to generate the sample with the given index for pixel (x, y).
+func (r *R2) StartPixelSample(x, y int, index int) {
+	r.x = x
+	r.y = y
+	r.index = uint32(index)
+	r.dimension = 0
+}
+
+// Get1D returns the next dimension of the current sample in [0, 1).
+func (r *R2) Get1D() float32 {
+	h := hash(r.x, r.y, r.dimension, r.seed)
+	r.dimension++
+
+	// Fixed-point arithmetic wraps around, which computes the fractional part for free.
+	return toFloat32(uint32(h) + r.index*r1Alpha)
+}
+
+// Get2D returns the next two dimensions of the current sample in [0, 1)^2.
+func (r *R2) Get2D() vec2.Vec2Impl {
+	h := hash(r.x, r.y, r.dimension, r.seed)
+	r.dimension += 2
+
+	return vec2.Vec2Impl{
+		X: toFloat32(uint32(h) + r.index*r2AlphaX),
+		Y: toFloat32(uint32(h>>32) + r.index*r2AlphaY),
+	}
+}
@@ -0,0 +1,84 @@
+// Package sampler provides low-discrepancy sample generators for Monte Carlo integration.
+//
+// All samplers implement the Sampler interface: StartPixelSample selects the pixel and the
+// sample index, and the following Get1D and Get2D calls return consecutive dimensions of
+// that sample. Each generator is deterministic for a given seed, pixel and sample index,
+// so samples can be generated in any order and from any number of goroutines as long as
+// each goroutine uses its own Sampler.
+package sampler
+
+import "github.com/flynn-nrg/go-vfx/math32/vec2"
+
+// oneMinusEpsilon is the largest float32 below 1.
+const oneMinusEpsilon = 0x1.fffffep-1
+
+// Sampler is the interface implemented by all the sample generators.
+type Sampler interface {
+	// StartPixelSample prepares the sampler to generate the sample with the given index for pixel (x, y).
+	StartPixelSample(x, y int, index int)
+	// Get1D returns the next dimension of the current sample in [0, 1).
+	Get1D() float32
+	// Get2D returns the next two dimensions of the current sample in [0, 1)^2.
+	Get2D() vec2.Vec2Impl
+}
+
+// mixBits scrambles the bits of v using the 64-bit finalizer from Stafford's Mix13.
+func mixBits(v uint64) uint64 {
+	v ^= v >> 31
+	v *= 0x7fb5d329728ea185
+	v ^= v >> 27
+	v *= 0x81dadef4bc2dd44d
+	v ^= v >> 33
+
+	return v
+}
+
+// hash combines the pixel coordinates, a dimension and a seed into a well-mixed 64-bit value.
+func hash(x, y int, dimension int, seed uint32) uint64 {
+	h := mixBits(uint64(uint32(x))<<32 | uint64(uint32(y)))
+	h = mixBits(h ^ uint64(uint32(dimension)))
+
+	return mixBits(h ^ uint64(seed))
+}
+
+// permutationElement returns the i-th element of a random permutation of [0, l) selected by p,
+// without storing the permutation. It implements Kensler's "Correlated Multi-Jittered Sampling", 2013.
+func permutationElement(i, l, p uint32) uint32 {
+	w := l - 1
+	w |= w >> 1
+	w |= w >> 2
+	w |= w >> 4
+	w |= w >> 8
+	w |= w >> 16
+
+	for {
+		i ^= p
+		i *= 0xe170893d
+		i ^= p >> 16
+		i ^= (i & w) >> 4
+		i ^= p >> 8
+		i *= 0x0929eb3f
+		i ^= p >> 23
+		i ^= (i & w) >> 1
+		i *= 1 | p>>27
+		i *= 0x6935fa69
+		i ^= (i & w) >> 11
+		i *= 0x74dcb303
+		i ^= (i & w) >> 2
+		i *= 0x9e501cc3
+		i ^= (i & w) >> 2
+		i *= 0xc860a3df
+		i &= w
+		i ^= i >> 5
+		if i < l {
+			break
+		}
+	}
+
+	return (i + p) % l
+}
+
+// toFloat32 maps a 0.32 fixed-point value to a float32 in [0, 1).
+func toFloat32(v uint32) float32 {
+	return float32(v>>8) * 0x1p-24
+}
@@ -0,0 +1,224 @@
+package sampler
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/fastrandom"
+	"github.com/flynn-nrg/go-vfx/math32/vec2"
+)
+
+var (
+	_ Sampler = (*Sobol)(nil)
+	_ Sampler = (*Halton)(nil)
+	_ Sampler = (*R2)(nil)
+	_ Sampler = (*fastrandom.XorShift)(nil)
+)
+
+const samplesPerPixel = 256
+
+func testSamplers() []struct {
+	name    string
+	sampler Sampler
+} {
+	return []struct {
+		name    string
+		sampler Sampler
+	}{
+		{name: "Sobol", sampler: NewSobol(samplesPerPixel, 1)},
+		{name: "Halton", sampler: NewHalton(1)},
+		{name: "R2", sampler: NewR2(1)},
+	}
+}
+
+// points returns the 2D samples of one pixel in the given dimension pair.
+func points(s Sampler, x, y int, n int, skip int) []vec2.Vec2Impl {
+	p := make([]vec2.Vec2Impl, n)
+	for i := range p {
+		s.StartPixelSample(x, y, i)
+		for j := 0; j < skip; j++ {
+			s.Get2D()
+		}
+		p[i] = s.Get2D()
+	}
+	return p
+}
+
+// starDiscrepancy returns the star discrepancy of a 2D point set, evaluated on the
+// anchored boxes whose corners are given by the point coordinates.
+func starDiscrepancy(p []vec2.Vec2Impl) float64 {
+	xs := make([]float32, 0, len(p)+1)
+	ys := make([]float32, 0, len(p)+1)
+	for _, v := range p {
+		xs = append(xs, v.X)
+		ys = append(ys, v.Y)
+	}
+	xs = append(xs, 1)
+	ys = append(ys, 1)
+
+	n := float64(len(p))
+	worst := 0.0
+	for _, bx := range xs {
+		for _, by := range ys {
+			open, closed := 0, 0
+			for _, v := range p {
+				if v.X <= bx && v.Y <= by {
+					closed++
+					if v.X < bx && v.Y < by {
+						open++
+					}
+				}
+			}
+			volume := float64(bx) * float64(by)
+			if d := volume - float64(open)/n; d > worst {
+				worst = d
+			}
+			if d := float64(closed)/n - volume; d > worst {
+				worst = d
+			}
+		}
+	}
+	return worst
+}
+
+func TestRangeAndDeterminism(t *testing.T) {
+	for _, test := range testSamplers() {
+		t.Run(test.name, func(t *testing.T) {
+			var first []float32
+			for pass := 0; pass < 2; pass++ {
+				var values []float32
+				// The second pass visits the samples backwards.
+				for k := 0; k < 64; k++ {
+					i := k
+					if pass == 1 {
+						i = 63 - k
+					}
+					test.sampler.StartPixelSample(3, 7, i)
+					for d := 0; d < 300; d++ {
+						v := test.sampler.Get1D()
+						p := test.sampler.Get2D()
+						for _, c := range []float32{v, p.X, p.Y} {
+							if c < 0 || c >= 1 {
+								t.Fatalf("sample %d dimension %d = %v, want a value in [0, 1)", i, d, c)
+							}
+						}
+						values = append(values, v, p.X, p.Y)
+					}
+				}
+				if pass == 0 {
+					first = values
+					continue
+				}
+				for k := 0; k < 64; k++ {
+					got := values[(63-k)*900 : (64-k)*900]
+					want := first[k*900 : (k+1)*900]
+					for j := range got {
+						if got[j] != want[j] {
+							t.Fatalf("sample %d value %d = %v on the second pass, want %v", k, j, got[j], want[j])
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPixelsAreDecorrelated(t *testing.T) {
+	for _, test := range testSamplers() {
+		t.Run(test.name, func(t *testing.T) {
+			a := points(test.sampler, 0, 0, 16, 0)
+			b := points(test.sampler, 1, 0, 16, 0)
+			for i := range a {
+				if a[i] == b[i] {
+					t.Fatalf("sample %d is %+v for two different pixels", i, a[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSobolStratification(t *testing.T) {
+	s := NewSobol(samplesPerPixel, 42)
+
+	// Every elementary interval of volume 1/256 must hold exactly one sample.
+	for _, dimension := range []int{0, 2, 10} {
+		p := points(s, 5, 9, samplesPerPixel, dimension/2)
+		for k := 0; k <= 8; k++ {
+			nx, ny := 1<<k, 1<<(8-k)
+			seen := make(map[[2]int]bool)
+			for _, v := range p {
+				cell := [2]int{int(v.X * float32(nx)), int(v.Y * float32(ny))}
+				if seen[cell] {
+					t.Fatalf("dimension %d: two samples in the %dx%d cell %v", dimension, nx, ny, cell)
+				}
+				seen[cell] = true
+			}
+		}
+	}
+
+	values := make([]float32, samplesPerPixel)
+	for i := range values {
+		s.StartPixelSample(5, 9, i)
+		values[i] = s.Get1D()
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	for i, v := range values {
+		if int(v*samplesPerPixel) != i {
+			t.Fatalf("1D sample %v is not in stratum %d", v, i)
+		}
+	}
+}
+
+func TestHaltonStratification(t *testing.T) {
+	h := NewHalton(42)
+
+	// 72 = 2^3 * 3^2 consecutive samples fill every 1/8 x 1/9 box of the first two dimensions.
+	p := points(h, 11, 4, 72, 0)
+	seen := make(map[[2]int]bool)
+	for _, v := range p {
+		cell := [2]int{int(v.X * 8), int(v.Y * 9)}
+		if seen[cell] {
+			t.Fatalf("two samples in the cell %v", cell)
+		}
+		seen[cell] = true
+	}
+}
+
+func TestDiscrepancy(t *testing.T) {
+	const n = 256
+
+	// White noise has an expected star discrepancy of roughly 1/sqrt(n).
+	random := starDiscrepancy(points(fastrandom.New(7), 0, 0, n, 0))
+
+	for _, test := range testSamplers() {
+		t.Run(test.name, func(t *testing.T) {
+			for _, skip := range []int{0, 1, 3} {
+				got := starDiscrepancy(points(test.sampler, 2, 3, n, skip))
+				if got > random/2 {
+					t.Errorf("star discrepancy of dimensions %d-%d = %v, white noise = %v", 2*skip, 2*skip+1, got, random)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGet2D(b *testing.B) {
+	samplers := append(testSamplers(), struct {
+		name    string
+		sampler Sampler
+	}{name: "XorShift", sampler: fastrandom.New(1)})
+
+	for _, test := range samplers {
+		b.Run(test.name, func(b *testing.B) {
+			var sum float32
+			for i := 0; i < b.N; i++ {
+				if i%16 == 0 {
+					test.sampler.StartPixelSample(i&1023, i>>10, i>>4)
+				}
+				p := test.sampler.Get2D()
+				sum += p.X + p.Y
+			}
+			_ = sum
+		})
+	}
+}
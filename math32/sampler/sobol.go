@@ -0,0 +1,106 @@
+package sampler
+
+import (
+	"math/bits"
+
+	"github.com/flynn-nrg/go-vfx/math32/vec2"
+)
+
+// sobolMatrices holds the generator matrices of the first two Sobol dimensions, one column per index bit.
+var sobolMatrices = func() [2][32]uint32 {
+	var m [2][32]uint32
+	v := uint32(1 << 31)
+	for i := 0; i < 32; i++ {
+		// The first dimension is the van der Corput sequence and the second
+		// one is generated by the primitive polynomial x + 1.
+		m[0][i] = 1 << (31 - i)
+		m[1][i] = v
+		v ^= v >> 1
+	}
+	return m
+}()
+
+// Sobol generates padded, Owen-scrambled Sobol samples.
+// Every 1D and 2D request uses the first one or two Sobol dimensions with an independent
+// scramble and sample order, which keeps the excellent stratification of the (0, 2)-sequence
+// in each pair of dimensions without the correlation artifacts of higher Sobol dimensions.
+type Sobol struct {
+	samplesPerPixel uint32
+	seed            uint32
+	x, y            int
+	index           uint32
+	dimension       int
+}
+
+// NewSobol returns a new Sobol sampler. samplesPerPixel should be a power of two to
+// get the best stratification.
+func NewSobol(samplesPerPixel int, seed uint32) *Sobol {
+	return &Sobol{
+		samplesPerPixel: uint32(samplesPerPixel),
+		seed:            seed,
+	}
+}
+
+// StartPixelSample prepares the sampler to generate the sample with the given index for pixel (x, y).
+func (s *Sobol) StartPixelSample(x, y int, index int) {
+	s.x = x
+	s.y = y
+	s.index = uint32(index)
+	s.dimension = 0
+}
+
+// Get1D returns the next dimension of the current sample in [0, 1).
+func (s *Sobol) Get1D() float32 {
+	h := hash(s.x, s.y, s.dimension, s.seed)
+	s.dimension++
+	index := s.sampleIndex(uint32(h))
+
+	return toFloat32(owenScramble(sobolSample(index, 0), uint32(h>>32)))
+}
+
+// Get2D returns the next two dimensions of the current sample in [0, 1)^2.
+func (s *Sobol) Get2D() vec2.Vec2Impl {
+	h := hash(s.x, s.y, s.dimension, s.seed)
+	s.dimension += 2
+	index := s.sampleIndex(uint32(h))
+	h2 := mixBits(h)
+
+	return vec2.Vec2Impl{
+		X: toFloat32(owenScramble(sobolSample(index, 0), uint32(h>>32))),
+		Y: toFloat32(owenScramble(sobolSample(index, 1), uint32(h2>>32))),
+	}
+}
+
+// sampleIndex returns the sample index after a random permutation of the pixel's samples.
+func (s *Sobol) sampleIndex(p uint32) uint32 {
+	if s.index >= s.samplesPerPixel {
+		return s.index
+	}
+
+	return permutationElement(s.index, s.samplesPerPixel, p)
+}
+
+// sobolSample returns the 0.32 fixed-point value of the given Sobol dimension for index a.
+func sobolSample(a uint32, dimension int) uint32 {
+	var v uint32
+	for i := 0; a != 0; i, a = i+1, a>>1 {
+		if a&1 != 0 {
+			v ^= sobolMatrices[dimension][i]
+		}
+	}
+
+	return v
+}
+
+// owenScramble applies a nested uniform scramble to the 0.32 fixed-point value v.
+// It uses the hash-based approximation from Burley, "Practical Hash-based Owen Scrambling", 2020.
+func owenScramble(v uint32, seed uint32) uint32 {
+	v = bits.Reverse32(v)
+	v ^= v * 0x3d20adea
+	v += seed
+	v *= (seed >> 16) | 1
+	v ^= v * 0x05526c56
+	v ^= v * 0x53a22864
+
+	return bits.Reverse32(v)
+}
@@ -0,0 +1,221 @@
+// Package sampling provides deterministic warps from uniform 2D samples to common
+// Monte Carlo sampling domains, each paired with its probability density function.
+//
+// Every warp takes a sample u in [0, 1)^2, so the random numbers can come from either
+// a pseudo-random generator or a low-discrepancy sequence. Directions are returned in a
+// local frame where the Z axis is the surface normal or the cone axis.
+package sampling
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec2"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+const (
+	invPi   = 1 / math32.Pi
+	inv2Pi  = 1 / (2 * math32.Pi)
+	inv4Pi  = 1 / (4 * math32.Pi)
+	piOver2 = math32.Pi / 2
+	piOver4 = math32.Pi / 4
+)
+
+// UniformHemisphere maps u to a direction uniformly distributed over the hemisphere around +Z.
+func UniformHemisphere(u vec2.Vec2Impl) vec3.Vec3Impl {
+	z := u.X
+	r := safeSqrt(1 - z*z)
+	sinPhi, cosPhi := math32.Sincos(2 * math32.Pi * u.Y)
+	return vec3.Vec3Impl{X: r * cosPhi, Y: r * sinPhi, Z: z}
+}
+
+// UniformHemispherePDF returns the solid angle density of UniformHemisphere.
+func UniformHemispherePDF() float32 {
+	return inv2Pi
+}
+
+// UniformSphere maps u to a direction uniformly distributed over the unit sphere.
+func UniformSphere(u vec2.Vec2Impl) vec3.Vec3Impl {
+	z := 1 - 2*u.X
+	r := safeSqrt(1 - z*z)
+	sinPhi, cosPhi := math32.Sincos(2 * math32.Pi * u.Y)
+	return vec3.Vec3Impl{X: r * cosPhi, Y: r * sinPhi, Z: z}
+}
+
+// UniformSpherePDF returns the solid angle density of UniformSphere.
+func UniformSpherePDF() float32 {
+	return inv4Pi
+}
+
+// ConcentricDisk maps u to a point uniformly distributed over the unit disk.
+// It uses Shirley and Chiu's concentric mapping, which keeps strata compact and
+// preserves the low-discrepancy properties of the input.
+func ConcentricDisk(u vec2.Vec2Impl) vec2.Vec2Impl {
+	ox := 2*u.X - 1
+	oy := 2*u.Y - 1
+	if ox == 0 && oy == 0 {
+		return vec2.Vec2Impl{}
+	}
+
+	var r, theta float32
+	if math32.Abs(ox) > math32.Abs(oy) {
+		r = ox
+		theta = piOver4 * (oy / ox)
+	} else {
+		r = oy
+		theta = piOver2 - piOver4*(ox/oy)
+	}
+
+	sinTheta, cosTheta := math32.Sincos(theta)
+	return vec2.Vec2Impl{X: r * cosTheta, Y: r * sinTheta}
+}
+
+// ConcentricDiskPDF returns the area density of ConcentricDisk.
+func ConcentricDiskPDF() float32 {
+	return invPi
+}
+
+// CosineHemisphere maps u to a direction over the hemisphere around +Z with a density
+// proportional to the cosine of the angle with +Z, using Malley's method.
+func CosineHemisphere(u vec2.Vec2Impl) vec3.Vec3Impl {
+	d := ConcentricDisk(u)
+	z := safeSqrt(1 - d.X*d.X - d.Y*d.Y)
+	return vec3.Vec3Impl{X: d.X, Y: d.Y, Z: z}
+}
+
+// CosineHemispherePDF returns the solid angle density of CosineHemisphere
+// for a direction whose angle with +Z has the supplied cosine.
+func CosineHemispherePDF(cosTheta float32) float32 {
+	return cosTheta * invPi
+}
+
+// UniformCone maps u to a direction uniformly distributed over the cone around +Z
+// whose half angle has the cosine cosThetaMax.
+func UniformCone(u vec2.Vec2Impl, cosThetaMax float32) vec3.Vec3Impl {
+	cosTheta := (1 - u.X) + u.X*cosThetaMax
+	sinTheta := safeSqrt(1 - cosTheta*cosTheta)
+	sinPhi, cosPhi := math32.Sincos(2 * math32.Pi * u.Y)
+	return vec3.Vec3Impl{X: sinTheta * cosPhi, Y: sinTheta * sinPhi, Z: cosTheta}
+}
+
+// UniformConePDF returns the solid angle density of UniformCone.
+func UniformConePDF(cosThetaMax float32) float32 {
+	return 1 / (2 * math32.Pi * (1 - cosThetaMax))
+}
+
+// UniformTriangle maps u to barycentric coordinates uniformly distributed over a triangle.
+// It uses Heitz's low-distortion mapping, "A Low-Distortion Map Between Triangle and Square", 2019.
+func UniformTriangle(u vec2.Vec2Impl) (b0, b1, b2 float32) {
+	if u.X < u.Y {
+		b0 = u.X / 2
+		b1 = u.Y - b0
+	} else {
+		b1 = u.Y / 2
+		b0 = u.X - b1
+	}
+
+	return b0, b1, 1 - b0 - b1
+}
+
+// UniformTrianglePDF returns the area density of UniformTriangle over the triangle v0, v1, v2.
+func UniformTrianglePDF(v0, v1, v2 vec3.Vec3Impl) float32 {
+	return 2 / vec3.Cross(vec3.Sub(v1, v0), vec3.Sub(v2, v0)).Length()
+}
+
+// SphericalTriangle maps u to a direction from p uniformly distributed over the solid angle
+// subtended by the triangle v0, v1, v2, using Arvo's method as formulated in pbrt-v4.
+// It also returns the solid angle density of the direction. The boolean result is false
+// when the triangle is degenerate as seen from p.
+func SphericalTriangle(v0, v1, v2, p vec3.Vec3Impl, u vec2.Vec2Impl) (vec3.Vec3Impl, float32, bool) {
+	a := vec3.UnitVector(vec3.Sub(v0, p))
+	b := vec3.UnitVector(vec3.Sub(v1, p))
+	c := vec3.UnitVector(vec3.Sub(v2, p))
+
+	// Normals of the planes containing the arcs of the spherical triangle.
+	nAB := vec3.Cross(a, b)
+	nBC := vec3.Cross(b, c)
+	nCA := vec3.Cross(c, a)
+	if nAB.SquaredLength() == 0 || nBC.SquaredLength() == 0 || nCA.SquaredLength() == 0 {
+		return vec3.Vec3Impl{}, 0, false
+	}
+	nAB = vec3.UnitVector(nAB)
+	nBC = vec3.UnitVector(nBC)
+	nCA = vec3.UnitVector(nCA)
+
+	// Interior angles of the spherical triangle, whose sum minus Pi is its area.
+	alpha := angleBetween(nAB, nCA.Neg())
+	beta := angleBetween(nBC, nAB.Neg())
+	gamma := angleBetween(nCA, nBC.Neg())
+
+	areaPlusPi := alpha + beta + gamma
+	area := areaPlusPi - math32.Pi
+	if area <= 0 {
+		return vec3.Vec3Impl{}, 0, false
+	}
+
+	// Pick the sub-triangle area and find the vertex c' that bounds it along the arc from a to c.
+	subAreaPlusPi := (1-u.X)*math32.Pi + u.X*areaPlusPi
+	sinSub, cosSub := math32.Sincos(subAreaPlusPi)
+	sinAlpha, cosAlpha := math32.Sincos(alpha)
+	sinPhi := sinSub*cosAlpha - cosSub*sinAlpha
+	cosPhi := cosSub*cosAlpha + sinSub*sinAlpha
+
+	k1 := cosPhi + cosAlpha
+	k2 := sinPhi - sinAlpha*vec3.Dot(a, b)
+	cosBp := (k2 + (k2*cosPhi-k1*sinPhi)*cosAlpha) / ((k2*sinPhi + k1*cosPhi) * sinAlpha)
+	cosBp = math32.Min(math32.Max(cosBp, -1), 1)
+	sinBp := safeSqrt(1 - cosBp*cosBp)
+	cp := vec3.Add(a.Scale(cosBp), vec3.UnitVector(gramSchmidt(c, a)).Scale(sinBp))
+
+	// Sample along the arc between b and c'.
+	cosTheta := 1 - u.Y*(1-vec3.Dot(cp, b))
+	sinTheta := safeSqrt(1 - cosTheta*cosTheta)
+	w := vec3.Add(b.Scale(cosTheta), vec3.UnitVector(gramSchmidt(cp, b)).Scale(sinTheta))
+
+	// The angle sum loses precision for small triangles, so the density uses the more accurate area formula.
+	return w, 1 / SphericalTriangleArea(a, b, c), true
+}
+
+// SphericalTrianglePDF returns the solid angle density of SphericalTriangle, which is the
+// reciprocal of the solid angle subtended by the triangle v0, v1, v2 as seen from p.
+func SphericalTrianglePDF(v0, v1, v2, p vec3.Vec3Impl) float32 {
+	solidAngle := SphericalTriangleArea(
+		vec3.UnitVector(vec3.Sub(v0, p)),
+		vec3.UnitVector(vec3.Sub(v1, p)),
+		vec3.UnitVector(vec3.Sub(v2, p)))
+	if solidAngle <= 0 {
+		return 0
+	}
+
+	return 1 / solidAngle
+}
+
+// SphericalTriangleArea returns the area of the spherical triangle with the supplied unit
+// vertices, using the formula from Van Oosterom and Strackee.
+func SphericalTriangleArea(a, b, c vec3.Vec3Impl) float32 {
+	return math32.Abs(2 * math32.Atan2(vec3.Dot(a, vec3.Cross(b, c)), 1+vec3.Dot(a, b)+vec3.Dot(a, c)+vec3.Dot(b, c)))
+}
+
+// angleBetween returns the angle between two unit vectors without the precision loss
+// of acos for nearly parallel or anti-parallel inputs.
+func angleBetween(v1, v2 vec3.Vec3Impl) float32 {
+	if vec3.Dot(v1, v2) < 0 {
+		return math32.Pi - 2*safeAsin(vec3.Add(v1, v2).Length()/2)
+	}
+
+	return 2 * safeAsin(vec3.Sub(v2, v1).Length()/2)
+}
+
+// gramSchmidt returns the component of v orthogonal to the unit vector w.
+func gramSchmidt(v, w vec3.Vec3Impl) vec3.Vec3Impl {
+	return vec3.Sub(v, w.Scale(vec3.Dot(v, w)))
+}
+
+// safeSqrt returns the square root of x, clamping small negative rounding errors to zero.
+func safeSqrt(x float32) float32 {
+	return math32.Sqrt(math32.Max(x, 0))
+}
+
+// safeAsin returns the arcsine of x after clamping it to [-1, 1].
+func safeAsin(x float32) float32 {
+	return math32.Asin(math32.Min(math32.Max(x, -1), 1))
+}
@@ -0,0 +1,250 @@
+package sampling
+
+import (
+	"math"
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32/vec2"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// gridSize is the number of strata per dimension used to integrate over the sample square.
+const gridSize = 128
+
+// integrate returns the stratified Monte Carlo estimate of the integral of f(u) over [0, 1)^2.
+func integrate(f func(u vec2.Vec2Impl) float64) float64 {
+	sum := 0.0
+	for i := 0; i < gridSize; i++ {
+		for j := 0; j < gridSize; j++ {
+			u := vec2.Vec2Impl{X: (float32(i) + 0.5) / gridSize, Y: (float32(j) + 0.5) / gridSize}
+			sum += f(u)
+		}
+	}
+	return sum / (gridSize * gridSize)
+}
+
+func assertUnit(t *testing.T, name string, v vec3.Vec3Impl) {
+	t.Helper()
+	if diff := math.Abs(float64(v.Length()) - 1); diff > 1e-5 {
+		t.Fatalf("%s returned %+v with length %v", name, v, v.Length())
+	}
+}
+
+func dot64(a, b vec3.Vec3Impl) float64 {
+	return float64(a.X)*float64(b.X) + float64(a.Y)*float64(b.Y) + float64(a.Z)*float64(b.Z)
+}
+
+func TestDirectionalWarps(t *testing.T) {
+	testData := []struct {
+		name string
+		warp func(u vec2.Vec2Impl) vec3.Vec3Impl
+		pdf  func(w vec3.Vec3Impl) float32
+		// inDomain reports whether a direction lies within the sampling domain.
+		inDomain func(w vec3.Vec3Impl) bool
+		// f is integrated over the domain by importance sampling and compared with want.
+		f    func(w vec3.Vec3Impl) float64
+		want float64
+	}{
+		{
+			name:     "Uniform hemisphere",
+			warp:     UniformHemisphere,
+			pdf:      func(vec3.Vec3Impl) float32 { return UniformHemispherePDF() },
+			inDomain: func(w vec3.Vec3Impl) bool { return w.Z >= 0 },
+			f:        func(w vec3.Vec3Impl) float64 { return float64(w.Z) * float64(w.Z) },
+			want:     2 * math.Pi / 3,
+		},
+		{
+			name:     "Uniform sphere",
+			warp:     UniformSphere,
+			pdf:      func(vec3.Vec3Impl) float32 { return UniformSpherePDF() },
+			inDomain: func(vec3.Vec3Impl) bool { return true },
+			f:        func(w vec3.Vec3Impl) float64 { return float64(w.X) * float64(w.X) },
+			want:     4 * math.Pi / 3,
+		},
+		{
+			name:     "Cosine hemisphere",
+			warp:     CosineHemisphere,
+			pdf:      func(w vec3.Vec3Impl) float32 { return CosineHemispherePDF(w.Z) },
+			inDomain: func(w vec3.Vec3Impl) bool { return w.Z >= 0 },
+			f:        func(w vec3.Vec3Impl) float64 { return float64(w.Z) * float64(w.Z) },
+			want:     2 * math.Pi / 3,
+		},
+		{
+			name:     "Uniform cone",
+			warp:     func(u vec2.Vec2Impl) vec3.Vec3Impl { return UniformCone(u, 0.8) },
+			pdf:      func(vec3.Vec3Impl) float32 { return UniformConePDF(0.8) },
+			inDomain: func(w vec3.Vec3Impl) bool { return w.Z >= 0.8-1e-6 },
+			f:        func(w vec3.Vec3Impl) float64 { return float64(w.Z) },
+			want:     math.Pi * (1 - 0.8*0.8),
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := integrate(func(u vec2.Vec2Impl) float64 {
+				w := test.warp(u)
+				assertUnit(t, test.name, w)
+				if !test.inDomain(w) {
+					t.Fatalf("%s(%+v) = %+v is outside the sampling domain", test.name, u, w)
+				}
+				return test.f(w) / float64(test.pdf(w))
+			})
+			if diff := math.Abs(got - test.want); diff > 1e-3*test.want {
+				t.Errorf("%s integral = %v, want %v (diff: %e)", test.name, got, test.want, diff)
+			}
+		})
+	}
+}
+
+func TestConcentricDisk(t *testing.T) {
+	got := integrate(func(u vec2.Vec2Impl) float64 {
+		p := ConcentricDisk(u)
+		if p.SquaredLength() > 1+1e-6 {
+			t.Fatalf("ConcentricDisk(%+v) = %+v is outside the unit disk", u, p)
+		}
+		return float64(p.X) * float64(p.X) / float64(ConcentricDiskPDF())
+	})
+
+	// The integral of x^2 over the unit disk is Pi/4.
+	if want := math.Pi / 4; math.Abs(got-want) > 1e-3*want {
+		t.Errorf("ConcentricDisk integral = %v, want %v", got, want)
+	}
+
+	if got := ConcentricDisk(vec2.Vec2Impl{X: 0.5, Y: 0.5}); got != (vec2.Vec2Impl{}) {
+		t.Errorf("ConcentricDisk(0.5, 0.5) = %+v, want the origin", got)
+	}
+}
+
+func TestUniformTriangle(t *testing.T) {
+	v0 := vec3.Vec3Impl{X: 1}
+	v1 := vec3.Vec3Impl{X: 4, Y: 1}
+	v2 := vec3.Vec3Impl{X: 2, Y: 3, Z: 1}
+	pdf := float64(UniformTrianglePDF(v0, v1, v2))
+
+	var sum [3]float64
+	area := integrate(func(u vec2.Vec2Impl) float64 {
+		b0, b1, b2 := UniformTriangle(u)
+		if b0 < 0 || b1 < 0 || b2 < -1e-7 || math.Abs(float64(b0+b1+b2)-1) > 1e-6 {
+			t.Fatalf("UniformTriangle(%+v) = (%v, %v, %v), want valid barycentrics", u, b0, b1, b2)
+		}
+		sum[0] += float64(b0 * b0)
+		sum[1] += float64(b1 * b1)
+		sum[2] += float64(b2 * b2)
+		return 1 / pdf
+	})
+
+	// The mean of a squared barycentric coordinate over a triangle is 1/6.
+	for i, s := range sum {
+		if got := s / (gridSize * gridSize); math.Abs(got-1.0/6) > 1e-3 {
+			t.Errorf("mean of b%d^2 = %v, want %v", i, got, 1.0/6)
+		}
+	}
+
+	cross := vec3.Cross(vec3.Sub(v1, v0), vec3.Sub(v2, v0))
+	if want := math.Sqrt(dot64(cross, cross)) / 2; math.Abs(area-want) > 1e-5*want {
+		t.Errorf("triangle area = %v, want %v", area, want)
+	}
+}
+
+// projectedSolidAngle64 returns the integral of the cosine with +Z over the solid angle
+// subtended by the polygon with the supplied unit vertices, following Lambert's formula.
+func projectedSolidAngle64(v []vec3.Vec3Impl) float64 {
+	sum := 0.0
+	for i := range v {
+		a, b := v[i], v[(i+1)%len(v)]
+		theta := math.Acos(dot64(a, b))
+		cz := float64(a.X)*float64(b.Y) - float64(a.Y)*float64(b.X)
+		cx := float64(a.Y)*float64(b.Z) - float64(a.Z)*float64(b.Y)
+		cy := float64(a.Z)*float64(b.X) - float64(a.X)*float64(b.Z)
+		sum += theta * cz / math.Sqrt(cx*cx+cy*cy+cz*cz)
+	}
+	return math.Abs(sum) / 2
+}
+
+// det64 returns the determinant of the matrix with columns a, b and c.
+func det64(a, b, c vec3.Vec3Impl) float64 {
+	ax, ay, az := float64(a.X), float64(a.Y), float64(a.Z)
+	bx, by, bz := float64(b.X), float64(b.Y), float64(b.Z)
+	cx, cy, cz := float64(c.X), float64(c.Y), float64(c.Z)
+	return ax*(by*cz-bz*cy) - bx*(ay*cz-az*cy) + cx*(ay*bz-az*by)
+}
+
+func TestSphericalTriangle(t *testing.T) {
+	testData := []struct {
+		name       string
+		v0, v1, v2 vec3.Vec3Impl
+	}{
+		{
+			name: "Small distant triangle",
+			v0:   vec3.Vec3Impl{X: -0.1, Y: -0.1, Z: 10},
+			v1:   vec3.Vec3Impl{X: 0.2, Y: -0.1, Z: 10},
+			v2:   vec3.Vec3Impl{X: 0, Y: 0.3, Z: 10.5},
+		},
+		{
+			name: "Large nearby triangle",
+			v0:   vec3.Vec3Impl{X: -2, Y: -1, Z: 1},
+			v1:   vec3.Vec3Impl{X: 2, Y: -1.5, Z: 0.5},
+			v2:   vec3.Vec3Impl{X: 0.5, Y: 3, Z: 1.5},
+		},
+		{
+			name: "Clockwise winding",
+			v0:   vec3.Vec3Impl{X: 0.5, Y: 3, Z: 1.5},
+			v1:   vec3.Vec3Impl{X: 2, Y: -1.5, Z: 0.5},
+			v2:   vec3.Vec3Impl{X: -2, Y: -1, Z: 1},
+		},
+	}
+
+	p := vec3.Vec3Impl{}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			a := vec3.UnitVector(test.v0)
+			b := vec3.UnitVector(test.v1)
+			c := vec3.UnitVector(test.v2)
+			det := det64(a, b, c)
+
+			wantPDF := SphericalTrianglePDF(test.v0, test.v1, test.v2, p)
+			got := integrate(func(u vec2.Vec2Impl) float64 {
+				w, pdf, ok := SphericalTriangle(test.v0, test.v1, test.v2, p, u)
+				if !ok {
+					t.Fatalf("SphericalTriangle(%+v) failed", u)
+				}
+				assertUnit(t, "SphericalTriangle", w)
+				if math.Abs(float64(pdf-wantPDF)) > 1e-4*float64(wantPDF) {
+					t.Fatalf("SphericalTriangle(%+v) pdf = %v, want %v", u, pdf, wantPDF)
+				}
+
+				// w must be a non-negative combination of the vertex directions.
+				const eps = 1e-4
+				if det64(w, b, c)/det < -eps || det64(a, w, c)/det < -eps || det64(a, b, w)/det < -eps {
+					t.Fatalf("SphericalTriangle(%+v) = %+v lies outside the triangle", u, w)
+				}
+
+				return float64(w.Z) / float64(pdf)
+			})
+
+			want := projectedSolidAngle64([]vec3.Vec3Impl{a, b, c})
+			if diff := math.Abs(got - want); diff > 1e-3*want {
+				t.Errorf("projected solid angle = %v, want %v (diff: %e)", got, want, diff)
+			}
+		})
+	}
+}
+
+func TestSphericalTriangleDegenerate(t *testing.T) {
+	v0 := vec3.Vec3Impl{X: 1, Z: 1}
+	v1 := vec3.Vec3Impl{X: 2, Z: 2}
+	v2 := vec3.Vec3Impl{Y: 1, Z: 1}
+
+	if _, _, ok := SphericalTriangle(v0, v1, v2, vec3.Vec3Impl{}, vec2.Vec2Impl{X: 0.5, Y: 0.5}); ok {
+		t.Errorf("SphericalTriangle() on a triangle seen edge on reported success")
+	}
+}
+
+func TestSphericalTriangleArea(t *testing.T) {
+	// One octant of the unit sphere has area Pi/2.
+	got := SphericalTriangleArea(vec3.Vec3Impl{X: 1}, vec3.Vec3Impl{Y: 1}, vec3.Vec3Impl{Z: 1})
+	if want := math.Pi / 2; math.Abs(float64(got)-want) > 1e-6 {
+		t.Errorf("SphericalTriangleArea() = %v, want %v", got, want)
+	}
+}
@@ -295,6 +295,65 @@ func TestCosAccuracy(t *testing.T) {
 	}
 }
 
+func TestSincos(t *testing.T) {
+	// Sincos must match Sin and Cos exactly, including across quadrants and signs
+	var testValues []float32
+	for i := -2000; i <= 2000; i++ {
+		testValues = append(testValues, float32(i)*0.0123)
+	}
+	testValues = append(testValues,
+		float32(math.Copysign(0, -1)), float32(Pi/2), float32(-Pi/2), float32(Pi), float32(3*Pi/2),
+		1e-30, -1e-30, 1e5, -1e5,
+	)
+
+	for _, x := range testValues {
+		s, c := Sincos(x)
+		if math.Float32bits(s) != math.Float32bits(Sin(x)) {
+			t.Errorf("Sincos(%v) sin = %v, want %v", x, s, Sin(x))
+		}
+		if math.Float32bits(c) != math.Float32bits(Cos(x)) {
+			t.Errorf("Sincos(%v) cos = %v, want %v", x, c, Cos(x))
+		}
+	}
+}
+
+func TestSincosAccuracy(t *testing.T) {
+	var maxError float32
+	var maxErrorAt float32
+
+	// Test over several periods
+	steps := 1000
+	for i := -steps; i <= steps; i++ {
+		x := float32(i) * float32(Pi) / 100.0
+		s, c := Sincos(x)
+
+		err := Max(Abs(s-float32(math.Sin(float64(x)))), Abs(c-float32(math.Cos(float64(x)))))
+		if err > maxError {
+			maxError = err
+			maxErrorAt = x
+		}
+	}
+
+	t.Logf("Sincos: Maximum error: %e at x=%v", maxError, maxErrorAt)
+
+	if maxError > 2e-6 {
+		t.Errorf("Sincos: Maximum error %e exceeds tolerance at x=%v", maxError, maxErrorAt)
+	}
+}
+
+func TestSincosSpecialCases(t *testing.T) {
+	for _, x := range []float32{NaN(), Inf(1), Inf(-1)} {
+		if s, c := Sincos(x); !IsNaN(s) || !IsNaN(c) {
+			t.Errorf("Sincos(%v) = %v, %v, want NaN, NaN", x, s, c)
+		}
+	}
+
+	negZero := float32(math.Copysign(0, -1))
+	if s, c := Sincos(negZero); !Signbit(s) || s != 0 || c != 1 {
+		t.Errorf("Sincos(-0) = %v, %v, want -0, 1", s, c)
+	}
+}
+
 // Benchmark Sin
 func BenchmarkSinSmall(b *testing.B) {
 	x := float32(0.5)
@@ -369,6 +428,25 @@ func BenchmarkCosFloat64(b *testing.B) {
 	_ = result
 }
 
+// Benchmark Sincos against separate Sin and Cos calls
+func BenchmarkSincos(b *testing.B) {
+	x := float32(Pi / 4)
+	var s, c float32
+	for i := 0; i < b.N; i++ {
+		s, c = Sincos(x)
+	}
+	_, _ = s, c
+}
+
+func BenchmarkSinAndCos(b *testing.B) {
+	x := float32(Pi / 4)
+	var s, c float32
+	for i := 0; i < b.N; i++ {
+		s, c = Sin(x), Cos(x)
+	}
+	_, _ = s, c
+}
+
 // Tan tests
 
 func TestTan(t *testing.T) {
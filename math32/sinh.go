@@ -0,0 +1,107 @@
+package math32
+
+// Sinh returns the hyperbolic sine of x.
+//
+// Special cases are:
+//
+//	Sinh(±0) = ±0
+//	Sinh(±Inf) = ±Inf
+//	Sinh(NaN) = NaN
+func Sinh(x float32) float32 {
+	// Handle special cases
+	if x == 0 || IsNaN(x) || IsInf(x, 0) {
+		return x // preserves sign of zero
+	}
+
+	// sinh is odd
+	neg := false
+	if x < 0 {
+		x = -x
+		neg = true
+	}
+
+	var result float32
+	if x > 9 {
+		result = coshLarge(x)
+	} else {
+		// sinh(x) = (e^x - e^-x)/2 = (t + t/(t+1))/2 with t = e^x - 1,
+		// which stays accurate for small x
+		t := Expm1(x)
+		result = 0.5 * (t + t/(t+1))
+	}
+
+	if neg {
+		return -result
+	}
+	return result
+}
+
+// Cosh returns the hyperbolic cosine of x.
+//
+// Special cases are:
+//
+//	Cosh(±0) = 1
+//	Cosh(±Inf) = +Inf
+//	Cosh(NaN) = NaN
+func Cosh(x float32) float32 {
+	// Handle special cases
+	if IsNaN(x) {
+		return x
+	}
+
+	// cosh is even
+	x = Abs(x)
+
+	if x > 9 {
+		return coshLarge(x)
+	}
+
+	t := Exp(x)
+	return 0.5 * (t + 1/t)
+}
+
+// coshLarge returns e^x/2, which is both sinh(x) and cosh(x) to float32 precision
+// for x > 9, where e^-x is negligible.
+func coshLarge(x float32) float32 {
+	if x > 88 {
+		// e^x overflows before e^x/2 does, so compute e^(x/2)·e^(x/2)/2
+		t := Exp(0.5 * x)
+		return (0.5 * t) * t
+	}
+
+	return 0.5 * Exp(x)
+}
+
+// Tanh returns the hyperbolic tangent of x.
+//
+// Special cases are:
+//
+//	Tanh(±0) = ±0
+//	Tanh(±Inf) = ±1
+//	Tanh(NaN) = NaN
+func Tanh(x float32) float32 {
+	// Handle special cases
+	if x == 0 || IsNaN(x) {
+		return x // preserves sign of zero
+	}
+
+	// tanh is odd
+	neg := false
+	if x < 0 {
+		x = -x
+		neg = true
+	}
+
+	// tanh(x) rounds to 1 for x > 10, which also covers +Inf
+	result := float32(1)
+	if x <= 10 {
+		// tanh(x) = (e^2x - 1)/(e^2x + 1) = t/(t+2) with t = e^2x - 1
+		t := Expm1(2 * x)
+		result = t / (t + 2)
+	}
+
+	if neg {
+		return -result
+	}
+	return result
+}
@@ -0,0 +1,163 @@
+package math32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSinh(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+		{"+Inf", Inf(1), Inf(1)},
+		{"-Inf", Inf(-1), Inf(-1)},
+		{"NaN", NaN(), NaN()},
+
+		// Tiny arguments return themselves
+		{"1e-10", 1e-10, 1e-10},
+		{"smallest denormal", -SmallestNonzeroFloat32, -SmallestNonzeroFloat32},
+
+		// sinh(x) is still finite although e^x overflows
+		{"89", 89, float32(math.Sinh(89))},
+		{"-89", -89, float32(math.Sinh(-89))},
+		{"overflow", 90, Inf(1)},
+		{"negative overflow", -90, Inf(-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sinh(tt.input); ulpDiff(got, tt.expected) > 5 || Signbit(got) != Signbit(tt.expected) {
+				t.Errorf("Sinh(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCosh(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"zero", 0, 1},
+		{"negative zero", Copysign(0, -1), 1},
+		{"+Inf", Inf(1), Inf(1)},
+		{"-Inf", Inf(-1), Inf(1)},
+		{"NaN", NaN(), NaN()},
+
+		// cosh(x) is still finite although e^x overflows
+		{"89", 89, float32(math.Cosh(89))},
+		{"-89", -89, float32(math.Cosh(-89))},
+		{"overflow", -90, Inf(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cosh(tt.input); ulpDiff(got, tt.expected) > 5 {
+				t.Errorf("Cosh(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTanh(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float32
+		expected float32
+	}{
+		// Special cases
+		{"zero", 0, 0},
+		{"negative zero", Copysign(0, -1), Copysign(0, -1)},
+		{"+Inf", Inf(1), 1},
+		{"-Inf", Inf(-1), -1},
+		{"NaN", NaN(), NaN()},
+
+		// Saturation and tiny arguments
+		{"20", 20, 1},
+		{"-20", -20, -1},
+		{"1e-10", 1e-10, 1e-10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tanh(tt.input); !identical(got, tt.expected) {
+				t.Errorf("Tanh(%v) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHyperbolicULP(t *testing.T) {
+	tests := []struct {
+		name   string
+		f      func(float32) float32
+		ref    func(float64) float64
+		inputs []float32
+		maxULP uint32
+	}{
+		{"Sinh", Sinh, math.Sinh, uniformInputs(100000, -90, 90), 6},
+		{"Sinh near zero", Sinh, math.Sinh, uniformInputs(100000, -1, 1), 6},
+		{"Sinh tiny", Sinh, math.Sinh, magnitudeInputs(100000, -45, 0), 6},
+		{"Cosh", Cosh, math.Cosh, uniformInputs(100000, -90, 90), 6},
+		{"Cosh near zero", Cosh, math.Cosh, uniformInputs(100000, -1, 1), 6},
+		{"Tanh", Tanh, math.Tanh, uniformInputs(100000, -12, 12), 6},
+		{"Tanh near zero", Tanh, math.Tanh, uniformInputs(100000, -1, 1), 6},
+		{"Tanh tiny", Tanh, math.Tanh, magnitudeInputs(100000, -45, 0), 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkULP(t, tt.name, tt.f, tt.ref, tt.inputs, tt.maxULP)
+		})
+	}
+}
+
+func TestHyperbolicIdentity(t *testing.T) {
+	// cosh²(x) - sinh²(x) = 1 and tanh(x) = sinh(x)/cosh(x)
+	for _, x := range []float32{-3, -1, -0.5, 0.1, 0.5, 1, 2, 3} {
+		s, c := Sinh(x), Cosh(x)
+
+		// The subtraction cancels, so the tolerance scales with cosh²(x)
+		if diff := Abs(c*c - s*s - 1); diff > 1e-6*c*c {
+			t.Errorf("cosh²(%v) - sinh²(%v) = %v, want 1", x, x, c*c-s*s)
+		}
+
+		if diff := Abs(Tanh(x) - s/c); diff > 1e-6 {
+			t.Errorf("tanh(%v) = %v, sinh/cosh = %v", x, Tanh(x), s/c)
+		}
+	}
+}
+
+func BenchmarkSinh(b *testing.B) {
+	x := float32(0.5)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Sinh(x)
+	}
+	_ = result
+}
+
+func BenchmarkCosh(b *testing.B) {
+	x := float32(0.5)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Cosh(x)
+	}
+	_ = result
+}
+
+func BenchmarkTanh(b *testing.B) {
+	x := float32(0.5)
+	var result float32
+	for i := 0; i < b.N; i++ {
+		result = Tanh(x)
+	}
+	_ = result
+}
@@ -0,0 +1,126 @@
+package math32
+
+// Slice variants of the scalar functions.
+//
+// Each function stores f(src[i]) in dst[i] for every element of src and panics if dst is
+// shorter than src. dst and src may be the same slice, but they must not overlap otherwise.
+//
+// On amd64 with AVX2 the bulk of the work is done eight elements at a time, and on arm64
+// four elements at a time with NEON. Elements outside the range handled by the vector
+// kernels, such as NaNs, infinities and very large arguments, fall back to the scalar
+// functions. The vector kernels evaluate the same operations in the same order as the
+// scalar code, so the results are bit-for-bit identical to the scalar functions.
+//
+// The exception is arm64, and amd64 built with GOAMD64=v3 or later, where the compiler
+// fuses the multiply-adds of the scalar polynomial kernels but not of the assembly ones.
+// Exp and Log then differ by at most 2 ULPs, and Sin and Cos by at most 2^-22 in absolute
+// terms, which is large in ULPs only close to their zeros. Pow(x, y) differs by at most
+// 2 + 4·|y·ln x| ULPs, as Exp amplifies the difference between the logarithms.
+//
+// Building with the purego tag disables the vector kernels on every architecture.
+
+// SinSlice computes Sin for every element of src.
+func SinSlice(dst, src []float32) {
+	dst = dst[:len(src)]
+	sinSlice(dst, src)
+}
+
+// CosSlice computes Cos for every element of src.
+func CosSlice(dst, src []float32) {
+	dst = dst[:len(src)]
+	cosSlice(dst, src)
+}
+
+// ExpSlice computes Exp for every element of src.
+func ExpSlice(dst, src []float32) {
+	dst = dst[:len(src)]
+	expSlice(dst, src)
+}
+
+// LogSlice computes Log for every element of src.
+func LogSlice(dst, src []float32) {
+	dst = dst[:len(src)]
+	logSlice(dst, src)
+}
+
+// PowSlice computes Pow(x[i], y[i]) for every element of x.
+// It panics if dst or y are shorter than x.
+func PowSlice(dst, x, y []float32) {
+	dst = dst[:len(x)]
+	y = y[:len(x)]
+	powSlice(dst, x, y)
+}
+
+// SqrtSlice computes Sqrt for every element of src.
+func SqrtSlice(dst, src []float32) {
+	dst = dst[:len(src)]
+	sqrtSlice(dst, src)
+}
+
+// FloorSlice computes Floor for every element of src.
+func FloorSlice(dst, src []float32) {
+	dst = dst[:len(src)]
+	floorSlice(dst, src)
+}
+
+// CeilSlice computes Ceil for every element of src.
+func CeilSlice(dst, src []float32) {
+	dst = dst[:len(src)]
+	ceilSlice(dst, src)
+}
+
+// sinSliceGeneric is the pure Go implementation of SinSlice.
+func sinSliceGeneric(dst, src []float32) {
+	for i := range src {
+		dst[i] = Sin(src[i])
+	}
+}
+
+// cosSliceGeneric is the pure Go implementation of CosSlice.
+func cosSliceGeneric(dst, src []float32) {
+	for i := range src {
+		dst[i] = Cos(src[i])
+	}
+}
+
+// expSliceGeneric is the pure Go implementation of ExpSlice.
+func expSliceGeneric(dst, src []float32) {
+	for i := range src {
+		dst[i] = Exp(src[i])
+	}
+}
+
+// logSliceGeneric is the pure Go implementation of LogSlice.
+func logSliceGeneric(dst, src []float32) {
+	for i := range src {
+		dst[i] = Log(src[i])
+	}
+}
+
+// powSliceGeneric is the pure Go implementation of PowSlice.
+func powSliceGeneric(dst, x, y []float32) {
+	for i := range x {
+		dst[i] = Pow(x[i], y[i])
+	}
+}
+
+// sqrtSliceGeneric is the pure Go implementation of SqrtSlice.
+func sqrtSliceGeneric(dst, src []float32) {
+	for i := range src {
+		dst[i] = Sqrt(src[i])
+	}
+}
+
+// floorSliceGeneric is the pure Go implementation of FloorSlice.
+func floorSliceGeneric(dst, src []float32) {
+	for i := range src {
+		dst[i] = Floor(src[i])
+	}
+}
+
+// ceilSliceGeneric is the pure Go implementation of CeilSlice.
+func ceilSliceGeneric(dst, src []float32) {
+	for i := range src {
+		dst[i] = Ceil(src[i])
+	}
+}
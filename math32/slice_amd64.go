@@ -0,0 +1,68 @@
+//go:build amd64 && !purego
+
+package math32
+
+import "github.com/flynn-nrg/go-vfx/math32/internal/cpu"
+
+// The *BlocksAVX2 functions are implemented in slice_amd64.s. They process eight
+// elements at a time and return the number of elements written, which is a multiple
+// of eight. The polynomial and square root kernels stop early at the first block
+// containing an element they do not handle, so that the caller can compute it with the
+// scalar function.
+
+func sinBlocksAVX2(dst, src []float32) int
+func cosBlocksAVX2(dst, src []float32) int
+func expBlocksAVX2(dst, src []float32) int
+func logBlocksAVX2(dst, src []float32) int
+func powBlocksAVX2(dst, x, y []float32) int
+func sqrtBlocksAVX2(dst, src []float32) int
+func floorBlocksAVX2(dst, src []float32) int
+func ceilBlocksAVX2(dst, src []float32) int
+
+// blockSize is the number of lanes processed by the AVX2 kernels.
+const blockSize = 8
+
+// vectorize runs the block kernel over src, computing the blocks it rejects and the
+// remaining tail with the scalar fallback.
+func vectorize(dst, src []float32, blocks func(dst, src []float32) int, scalar func(dst, src []float32)) {
+	if !cpu.HasAVX2 {
+		scalar(dst, src)
+		return
+	}
+
+	for i := 0; i < len(src); {
+		i += blocks(dst[i:], src[i:])
+		end := i + blockSize
+		if end > len(src) {
+			end = len(src)
+		}
+		scalar(dst[i:end], src[i:end])
+		i = end
+	}
+}
+
+func sinSlice(dst, src []float32)   { vectorize(dst, src, sinBlocksAVX2, sinSliceGeneric) }
+func cosSlice(dst, src []float32)   { vectorize(dst, src, cosBlocksAVX2, cosSliceGeneric) }
+func expSlice(dst, src []float32)   { vectorize(dst, src, expBlocksAVX2, expSliceGeneric) }
+func logSlice(dst, src []float32)   { vectorize(dst, src, logBlocksAVX2, logSliceGeneric) }
+func sqrtSlice(dst, src []float32)  { vectorize(dst, src, sqrtBlocksAVX2, sqrtSliceGeneric) }
+func floorSlice(dst, src []float32) { vectorize(dst, src, floorBlocksAVX2, floorSliceGeneric) }
+func ceilSlice(dst, src []float32)  { vectorize(dst, src, ceilBlocksAVX2, ceilSliceGeneric) }
+
+// powSlice is vectorize for PowSlice, whose kernel and scalar fallback take two arguments.
+func powSlice(dst, x, y []float32) {
+	if !cpu.HasAVX2 {
+		powSliceGeneric(dst, x, y)
+		return
+	}
+
+	for i := 0; i < len(x); {
+		i += powBlocksAVX2(dst[i:], x[i:], y[i:])
+		end := i + blockSize
+		if end > len(x) {
+			end = len(x)
+		}
+		powSliceGeneric(dst[i:end], x[i:end], y[i:end])
+		i = end
+	}
+}
@@ -0,0 +1,722 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// Constants are broadcast to all lanes with VBROADCASTSS.
+// The polynomial coefficients are the float32 roundings of the ones used by sinKernel,
+// cosKernel, expKernel and logKernel.
+
+// Clears the sign bit
+DATA absMask<>+0(SB)/4, $0x7fffffff
+GLOBL absMask<>(SB), RODATA|NOPTR, $4
+// Integer 1
+DATA intOne<>+0(SB)/4, $0x00000001
+GLOBL intOne<>(SB), RODATA|NOPTR, $4
+// Integer 2
+DATA intTwo<>+0(SB)/4, $0x00000002
+GLOBL intTwo<>(SB), RODATA|NOPTR, $4
+// Exponent bias
+DATA int127<>+0(SB)/4, $0x0000007f
+GLOBL int127<>(SB), RODATA|NOPTR, $4
+// 1.0
+DATA one<>+0(SB)/4, $0x3f800000
+GLOBL one<>(SB), RODATA|NOPTR, $4
+// 2.0
+DATA two<>+0(SB)/4, $0x40000000
+GLOBL two<>(SB), RODATA|NOPTR, $4
+// 0.5
+DATA half<>+0(SB)/4, $0x3f000000
+GLOBL half<>(SB), RODATA|NOPTR, $4
+// -0.5
+DATA negHalf<>+0(SB)/4, $0xbf000000
+GLOBL negHalf<>(SB), RODATA|NOPTR, $4
+// -1.0
+DATA negOne<>+0(SB)/4, $0xbf800000
+GLOBL negOne<>(SB), RODATA|NOPTR, $4
+// 2^20, largest argument handled by the sin and cos kernels
+DATA sinMaxArg<>+0(SB)/4, $0x49800000
+GLOBL sinMaxArg<>(SB), RODATA|NOPTR, $4
+// 2/Pi
+DATA invPi2<>+0(SB)/4, $0x3f22f983
+GLOBL invPi2<>(SB), RODATA|NOPTR, $4
+// High bits of Pi/2
+DATA pi2Hi<>+0(SB)/4, $0x3fc90000
+GLOBL pi2Hi<>(SB), RODATA|NOPTR, $4
+// Low bits of Pi/2
+DATA pi2Lo<>+0(SB)/4, $0x39fdaa22
+GLOBL pi2Lo<>(SB), RODATA|NOPTR, $4
+DATA sinS1<>+0(SB)/4, $0xbe2aaaab
+GLOBL sinS1<>(SB), RODATA|NOPTR, $4
+DATA sinS2<>+0(SB)/4, $0x3c088889
+GLOBL sinS2<>(SB), RODATA|NOPTR, $4
+DATA sinS3<>+0(SB)/4, $0xb9500d01
+GLOBL sinS3<>(SB), RODATA|NOPTR, $4
+DATA sinS4<>+0(SB)/4, $0x3638ef1b
+GLOBL sinS4<>(SB), RODATA|NOPTR, $4
+DATA sinS5<>+0(SB)/4, $0xb2d72f34
+GLOBL sinS5<>(SB), RODATA|NOPTR, $4
+DATA cosC1<>+0(SB)/4, $0x3d2aaaab
+GLOBL cosC1<>(SB), RODATA|NOPTR, $4
+DATA cosC2<>+0(SB)/4, $0xbab60b61
+GLOBL cosC2<>(SB), RODATA|NOPTR, $4
+DATA cosC3<>+0(SB)/4, $0x37d00d01
+GLOBL cosC3<>(SB), RODATA|NOPTR, $4
+DATA cosC4<>+0(SB)/4, $0xb493f27c
+GLOBL cosC4<>(SB), RODATA|NOPTR, $4
+DATA cosC5<>+0(SB)/4, $0x310f74f6
+GLOBL cosC5<>(SB), RODATA|NOPTR, $4
+// 80, largest argument handled by the exp kernel
+DATA expMaxArg<>+0(SB)/4, $0x42a00000
+GLOBL expMaxArg<>(SB), RODATA|NOPTR, $4
+// -80, smallest argument handled by the exp kernel
+DATA expMinArg<>+0(SB)/4, $0xc2a00000
+GLOBL expMinArg<>(SB), RODATA|NOPTR, $4
+// 1/ln(2)
+DATA invLn2<>+0(SB)/4, $0x3fb8aa3b
+GLOBL invLn2<>(SB), RODATA|NOPTR, $4
+// High bits of ln(2)
+DATA ln2Hi<>+0(SB)/4, $0x3f317200
+GLOBL ln2Hi<>(SB), RODATA|NOPTR, $4
+// Low bits of ln(2)
+DATA ln2Lo<>+0(SB)/4, $0x35bfbe8e
+GLOBL ln2Lo<>(SB), RODATA|NOPTR, $4
+DATA expC3<>+0(SB)/4, $0x3e2aaaab
+GLOBL expC3<>(SB), RODATA|NOPTR, $4
+DATA expC4<>+0(SB)/4, $0x3d2aaaab
+GLOBL expC4<>(SB), RODATA|NOPTR, $4
+DATA expC5<>+0(SB)/4, $0x3c088889
+GLOBL expC5<>(SB), RODATA|NOPTR, $4
+DATA expC6<>+0(SB)/4, $0x3ab60b61
+GLOBL expC6<>(SB), RODATA|NOPTR, $4
+// Mantissa bits, also the largest subnormal
+DATA mantMask<>+0(SB)/4, $0x007fffff
+GLOBL mantMask<>(SB), RODATA|NOPTR, $4
+// +Inf
+DATA infBits<>+0(SB)/4, $0x7f800000
+GLOBL infBits<>(SB), RODATA|NOPTR, $4
+// sqrt(2)
+DATA sqrt2<>+0(SB)/4, $0x3fb504f3
+GLOBL sqrt2<>(SB), RODATA|NOPTR, $4
+DATA logL1<>+0(SB)/4, $0x3f2aaaab
+GLOBL logL1<>(SB), RODATA|NOPTR, $4
+DATA logL2<>+0(SB)/4, $0x3ecccccd
+GLOBL logL2<>(SB), RODATA|NOPTR, $4
+DATA logL3<>+0(SB)/4, $0x3e924925
+GLOBL logL3<>(SB), RODATA|NOPTR, $4
+DATA logL4<>+0(SB)/4, $0x3e638e29
+GLOBL logL4<>(SB), RODATA|NOPTR, $4
+DATA logL5<>+0(SB)/4, $0x3e3a3325
+GLOBL logL5<>(SB), RODATA|NOPTR, $4
+DATA logL6<>+0(SB)/4, $0x3e1cd04f
+GLOBL logL6<>(SB), RODATA|NOPTR, $4
+
+// Every kernel has the signature func(dst, src []float32) int. It processes whole
+// blocks of eight elements from src and returns the number of elements written.
+// The polynomial kernels stop at the first block holding an element outside the
+// range they handle.
+
+// func sinBlocksAVX2(dst, src []float32) int
+TEXT ·sinBlocksAVX2(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	XORQ	AX, AX
+
+sinLoop:
+	CMPQ	CX, $8
+	JLT	sinDone
+	VMOVUPS	(SI), Y0                // Y0 = x
+
+	// Split x into |x| and its sign, and reject NaNs and large arguments.
+	VBROADCASTSS	absMask<>(SB), Y1
+	VANDPS	Y0, Y1, Y1               // Y1 = |x|
+	VXORPS	Y1, Y0, Y2               // Y2 = sign bit of x
+	VBROADCASTSS	sinMaxArg<>(SB), Y3
+	VCMPPS	$0x12, Y3, Y1, Y4        // |x| <= sinMaxArg, false for NaN
+	VMOVMSKPS	Y4, DX
+	CMPL	DX, $0xff
+	JNE	sinDone
+
+	// Quadrant j and reduced argument y = |x| - j*pi2Hi - j*pi2Lo.
+	VBROADCASTSS	invPi2<>(SB), Y3
+	VMULPS	Y3, Y1, Y3
+	VCVTTPS2DQ	Y3, Y3               // Y3 = j
+	VCVTDQ2PS	Y3, Y4               // Y4 = float32(j)
+	VBROADCASTSS	pi2Hi<>(SB), Y5
+	VMULPS	Y5, Y4, Y5
+	VSUBPS	Y5, Y1, Y5
+	VBROADCASTSS	pi2Lo<>(SB), Y6
+	VMULPS	Y6, Y4, Y6
+	VSUBPS	Y6, Y5, Y5               // Y5 = y
+	VMULPS	Y5, Y5, Y6               // Y6 = z = y*y
+
+	// sinKernel: y + y*z*(S1 + z*(S2 + z*(S3 + z*(S4 + z*S5))))
+	VBROADCASTSS	sinS5<>(SB), Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS4<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS3<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS2<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS1<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y5, Y8
+	VMULPS	Y7, Y8, Y8
+	VADDPS	Y8, Y5, Y7               // Y7 = sinKernel(y)
+
+	// cosKernel: 1 - 0.5*z + z*z*(C1 + z*(C2 + z*(C3 + z*(C4 + z*C5))))
+	VBROADCASTSS	cosC5<>(SB), Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC4<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC3<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC2<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC1<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y6, Y9
+	VMULPS	Y8, Y9, Y8
+	VBROADCASTSS	half<>(SB), Y9
+	VMULPS	Y6, Y9, Y9
+	VBROADCASTSS	one<>(SB), Y10
+	VSUBPS	Y9, Y10, Y10
+	VADDPS	Y8, Y10, Y8              // Y8 = cosKernel(y)
+
+	// Odd quadrants use the cosine kernel.
+	VBROADCASTSS	intOne<>(SB), Y9
+	VPAND	Y9, Y3, Y10
+	VPCMPEQD	Y9, Y10, Y10
+	VBLENDVPS	Y10, Y8, Y7, Y11
+
+	// Quadrants 2 and 3 flip the sign, as does a negative x.
+	VBROADCASTSS	intTwo<>(SB), Y9
+	VPAND	Y9, Y3, Y10
+	VPSLLD	$30, Y10, Y10
+	VPXOR	Y2, Y10, Y10
+	VXORPS	Y10, Y11, Y11
+
+	VMOVUPS	Y11, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	sinLoop
+
+sinDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
+
+// func cosBlocksAVX2(dst, src []float32) int
+TEXT ·cosBlocksAVX2(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	XORQ	AX, AX
+
+cosLoop:
+	CMPQ	CX, $8
+	JLT	cosDone
+	VMOVUPS	(SI), Y0                // Y0 = x
+
+	// Cosine is even, so only |x| matters. Reject NaNs and large arguments.
+	VBROADCASTSS	absMask<>(SB), Y1
+	VANDPS	Y0, Y1, Y1               // Y1 = |x|
+	VBROADCASTSS	sinMaxArg<>(SB), Y3
+	VCMPPS	$0x12, Y3, Y1, Y4        // |x| <= sinMaxArg, false for NaN
+	VMOVMSKPS	Y4, DX
+	CMPL	DX, $0xff
+	JNE	cosDone
+
+	// Quadrant j and reduced argument y = |x| - j*pi2Hi - j*pi2Lo.
+	VBROADCASTSS	invPi2<>(SB), Y3
+	VMULPS	Y3, Y1, Y3
+	VCVTTPS2DQ	Y3, Y3               // Y3 = j
+	VCVTDQ2PS	Y3, Y4               // Y4 = float32(j)
+	VBROADCASTSS	pi2Hi<>(SB), Y5
+	VMULPS	Y5, Y4, Y5
+	VSUBPS	Y5, Y1, Y5
+	VBROADCASTSS	pi2Lo<>(SB), Y6
+	VMULPS	Y6, Y4, Y6
+	VSUBPS	Y6, Y5, Y5               // Y5 = y
+	VMULPS	Y5, Y5, Y6               // Y6 = z = y*y
+
+	// sinKernel: y + y*z*(S1 + z*(S2 + z*(S3 + z*(S4 + z*S5))))
+	VBROADCASTSS	sinS5<>(SB), Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS4<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS3<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS2<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	sinS1<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y5, Y8
+	VMULPS	Y7, Y8, Y8
+	VADDPS	Y8, Y5, Y7               // Y7 = sinKernel(y)
+
+	// cosKernel: 1 - 0.5*z + z*z*(C1 + z*(C2 + z*(C3 + z*(C4 + z*C5))))
+	VBROADCASTSS	cosC5<>(SB), Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC4<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC3<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC2<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y8, Y8
+	VBROADCASTSS	cosC1<>(SB), Y9
+	VADDPS	Y9, Y8, Y8
+	VMULPS	Y6, Y6, Y9
+	VMULPS	Y8, Y9, Y8
+	VBROADCASTSS	half<>(SB), Y9
+	VMULPS	Y6, Y9, Y9
+	VBROADCASTSS	one<>(SB), Y10
+	VSUBPS	Y9, Y10, Y10
+	VADDPS	Y8, Y10, Y8              // Y8 = cosKernel(y)
+
+	// Odd quadrants use the sine kernel.
+	VBROADCASTSS	intOne<>(SB), Y9
+	VPAND	Y9, Y3, Y10
+	VPCMPEQD	Y9, Y10, Y10
+	VBLENDVPS	Y10, Y7, Y8, Y11
+
+	// Quadrants 1 and 2 flip the sign.
+	VPADDD	Y9, Y3, Y10
+	VBROADCASTSS	intTwo<>(SB), Y9
+	VPAND	Y9, Y10, Y10
+	VPSLLD	$30, Y10, Y10
+	VXORPS	Y10, Y11, Y11
+
+	VMOVUPS	Y11, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	cosLoop
+
+cosDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
+
+// func expBlocksAVX2(dst, src []float32) int
+TEXT ·expBlocksAVX2(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	XORQ	AX, AX
+
+expLoop:
+	CMPQ	CX, $8
+	JLT	expDone
+	VMOVUPS	(SI), Y0                // Y0 = x
+
+	// Reject NaNs and arguments whose result could overflow or be subnormal.
+	VBROADCASTSS	expMaxArg<>(SB), Y1
+	VCMPPS	$0x12, Y1, Y0, Y2        // x <= expMaxArg
+	VBROADCASTSS	expMinArg<>(SB), Y1
+	VCMPPS	$0x1d, Y1, Y0, Y3        // x >= expMinArg
+	VANDPS	Y2, Y3, Y2
+	VMOVMSKPS	Y2, DX
+	CMPL	DX, $0xff
+	JNE	expDone
+
+	// k = int32(x*invLn2 + 0.5), or int32(x*invLn2 - 0.5) for negative x.
+	VBROADCASTSS	invLn2<>(SB), Y1
+	VMULPS	Y1, Y0, Y1
+	VXORPS	Y2, Y2, Y2
+	VCMPPS	$0x11, Y2, Y0, Y3        // x < 0
+	VBROADCASTSS	half<>(SB), Y4
+	VBROADCASTSS	negHalf<>(SB), Y5
+	VBLENDVPS	Y3, Y5, Y4, Y4
+	VADDPS	Y4, Y1, Y1
+	VCVTTPS2DQ	Y1, Y1               // Y1 = k
+	VCVTDQ2PS	Y1, Y2               // Y2 = float32(k)
+
+	// r = (x - k*ln2Hi) - k*ln2Lo
+	VBROADCASTSS	ln2Hi<>(SB), Y3
+	VMULPS	Y3, Y2, Y3
+	VSUBPS	Y3, Y0, Y3
+	VBROADCASTSS	ln2Lo<>(SB), Y4
+	VMULPS	Y4, Y2, Y4
+	VSUBPS	Y4, Y3, Y3               // Y3 = r
+	VMULPS	Y3, Y3, Y4               // Y4 = r*r
+
+	// expKernel: 1 + r + r*r*(c2 + r*(c3 + r*(c4 + r*(c5 + r*c6))))
+	VBROADCASTSS	expC6<>(SB), Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	expC5<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	expC4<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	expC3<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	half<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VBROADCASTSS	one<>(SB), Y6
+	VADDPS	Y3, Y6, Y6
+	VMULPS	Y5, Y4, Y5
+	VADDPS	Y5, Y6, Y6               // Y6 = expKernel(r)
+
+	// Scale by 2^k by adding k to the exponent field.
+	VPSLLD	$23, Y1, Y1
+	VPADDD	Y1, Y6, Y6
+
+	VMOVUPS	Y6, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	expLoop
+
+expDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
+
+// func logBlocksAVX2(dst, src []float32) int
+TEXT ·logBlocksAVX2(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	XORQ	AX, AX
+
+logLoop:
+	CMPQ	CX, $8
+	JLT	logDone
+	VMOVUPS	(SI), Y0                // Y0 = x
+
+	// Only positive, normal and finite x are handled.
+	// Negative values have the sign bit set and compare below mantMask.
+	VBROADCASTSS	mantMask<>(SB), Y1
+	VPCMPGTD	Y1, Y0, Y2           // bits(x) > largest subnormal
+	VBROADCASTSS	infBits<>(SB), Y1
+	VPCMPGTD	Y0, Y1, Y3           // bits(x) < +Inf
+	VPAND	Y2, Y3, Y2
+	VMOVMSKPS	Y2, DX
+	CMPL	DX, $0xff
+	JNE	logDone
+
+	// Unbiased exponent k and mantissa f in [1, 2).
+	VPSRLD	$23, Y0, Y1
+	VBROADCASTSS	int127<>(SB), Y2
+	VPSUBD	Y2, Y1, Y1               // Y1 = k
+	VBROADCASTSS	mantMask<>(SB), Y2
+	VPAND	Y2, Y0, Y2
+	VBROADCASTSS	one<>(SB), Y3
+	VPOR	Y3, Y2, Y2               // Y2 = f
+
+	// Reduce f to [sqrt(2)/2, sqrt(2)].
+	VBROADCASTSS	sqrt2<>(SB), Y4
+	VCMPPS	$0x1e, Y4, Y2, Y4        // f > sqrt(2)
+	VBROADCASTSS	half<>(SB), Y5
+	VMULPS	Y5, Y2, Y5
+	VBLENDVPS	Y4, Y5, Y2, Y2
+	VPSUBD	Y4, Y1, Y1               // k++ where the mask is all ones
+
+	// s = (f - 1) / (f + 1)
+	VSUBPS	Y3, Y2, Y5
+	VADDPS	Y3, Y2, Y6
+	VDIVPS	Y6, Y5, Y5               // Y5 = s
+	VMULPS	Y5, Y5, Y6               // Y6 = s*s
+
+	// logKernel: s*(2 + s2*(L1 + s2*(L2 + s2*(L3 + s2*(L4 + s2*(L5 + s2*L6))))))
+	VBROADCASTSS	logL6<>(SB), Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL5<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL4<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL3<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL2<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL1<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y7, Y6, Y7
+	VBROADCASTSS	two<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y7, Y5, Y7               // Y7 = logKernel(s)
+
+	// k*ln2Hi + (k*ln2Lo + logKernel(s))
+	VCVTDQ2PS	Y1, Y1
+	VBROADCASTSS	ln2Lo<>(SB), Y8
+	VMULPS	Y8, Y1, Y8
+	VADDPS	Y7, Y8, Y8
+	VBROADCASTSS	ln2Hi<>(SB), Y9
+	VMULPS	Y9, Y1, Y9
+	VADDPS	Y8, Y9, Y9
+
+	VMOVUPS	Y9, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	logLoop
+
+logDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
+
+// func powBlocksAVX2(dst, x, y []float32) int
+TEXT ·powBlocksAVX2(SB),NOSPLIT,$0-80
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	x_base+24(FP), SI
+	MOVQ	x_len+32(FP), CX
+	MOVQ	y_base+48(FP), R8
+	XORQ	AX, AX
+
+powLoop:
+	CMPQ	CX, $8
+	JLT	powDone
+	VMOVUPS	(SI), Y0                // Y0 = x
+	VMOVUPS	(R8), Y10               // Y10 = y
+
+	// Only positive, normal and finite x are handled.
+	// Negative values have the sign bit set and compare below mantMask.
+	VBROADCASTSS	mantMask<>(SB), Y1
+	VPCMPGTD	Y1, Y0, Y2           // bits(x) > largest subnormal
+	VBROADCASTSS	infBits<>(SB), Y1
+	VPCMPGTD	Y0, Y1, Y3           // bits(x) < +Inf
+	VPAND	Y2, Y3, Y2
+	VMOVMSKPS	Y2, DX
+	CMPL	DX, $0xff
+	JNE	powDone
+
+	// Unbiased exponent k and mantissa f in [1, 2).
+	VPSRLD	$23, Y0, Y1
+	VBROADCASTSS	int127<>(SB), Y2
+	VPSUBD	Y2, Y1, Y1               // Y1 = k
+	VBROADCASTSS	mantMask<>(SB), Y2
+	VPAND	Y2, Y0, Y2
+	VBROADCASTSS	one<>(SB), Y3
+	VPOR	Y3, Y2, Y2               // Y2 = f
+
+	// Reduce f to [sqrt(2)/2, sqrt(2)].
+	VBROADCASTSS	sqrt2<>(SB), Y4
+	VCMPPS	$0x1e, Y4, Y2, Y4        // f > sqrt(2)
+	VBROADCASTSS	half<>(SB), Y5
+	VMULPS	Y5, Y2, Y5
+	VBLENDVPS	Y4, Y5, Y2, Y2
+	VPSUBD	Y4, Y1, Y1               // k++ where the mask is all ones
+
+	// s = (f - 1) / (f + 1)
+	VSUBPS	Y3, Y2, Y5
+	VADDPS	Y3, Y2, Y6
+	VDIVPS	Y6, Y5, Y5               // Y5 = s
+	VMULPS	Y5, Y5, Y6               // Y6 = s*s
+
+	// logKernel: s*(2 + s2*(L1 + s2*(L2 + s2*(L3 + s2*(L4 + s2*(L5 + s2*L6))))))
+	VBROADCASTSS	logL6<>(SB), Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL5<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL4<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL3<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL2<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y6, Y7, Y7
+	VBROADCASTSS	logL1<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y7, Y6, Y7
+	VBROADCASTSS	two<>(SB), Y8
+	VADDPS	Y8, Y7, Y7
+	VMULPS	Y7, Y5, Y7               // Y7 = logKernel(s)
+
+	// k*ln2Hi + (k*ln2Lo + logKernel(s))
+	VCVTDQ2PS	Y1, Y1
+	VBROADCASTSS	ln2Lo<>(SB), Y8
+	VMULPS	Y8, Y1, Y8
+	VADDPS	Y7, Y8, Y8
+	VBROADCASTSS	ln2Hi<>(SB), Y9
+	VMULPS	Y9, Y1, Y9
+	VADDPS	Y8, Y9, Y9
+
+	// Reject products outside the range of the exp kernel, including NaNs.
+	VMULPS	Y9, Y10, Y9              // Y9 = p = y*Log(x)
+	VBROADCASTSS	absMask<>(SB), Y1
+	VANDPS	Y9, Y1, Y1
+	VBROADCASTSS	expMaxArg<>(SB), Y2
+	VCMPPS	$0x12, Y2, Y1, Y2        // |p| <= expMaxArg
+	VMOVMSKPS	Y2, DX
+	CMPL	DX, $0xff
+	JNE	powDone
+
+	// k = int32(p*invLn2 + 0.5), or int32(p*invLn2 - 0.5) for negative p.
+	VBROADCASTSS	invLn2<>(SB), Y1
+	VMULPS	Y1, Y9, Y1
+	VXORPS	Y2, Y2, Y2
+	VCMPPS	$0x11, Y2, Y9, Y3        // p < 0
+	VBROADCASTSS	half<>(SB), Y4
+	VBROADCASTSS	negHalf<>(SB), Y5
+	VBLENDVPS	Y3, Y5, Y4, Y4
+	VADDPS	Y4, Y1, Y1
+	VCVTTPS2DQ	Y1, Y1               // Y1 = k
+	VCVTDQ2PS	Y1, Y2               // Y2 = float32(k)
+
+	// r = (p - k*ln2Hi) - k*ln2Lo
+	VBROADCASTSS	ln2Hi<>(SB), Y3
+	VMULPS	Y3, Y2, Y3
+	VSUBPS	Y3, Y9, Y3
+	VBROADCASTSS	ln2Lo<>(SB), Y4
+	VMULPS	Y4, Y2, Y4
+	VSUBPS	Y4, Y3, Y3               // Y3 = r
+	VMULPS	Y3, Y3, Y4               // Y4 = r*r
+
+	// expKernel: 1 + r + r*r*(c2 + r*(c3 + r*(c4 + r*(c5 + r*c6))))
+	VBROADCASTSS	expC6<>(SB), Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	expC5<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	expC4<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	expC3<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VMULPS	Y3, Y5, Y5
+	VBROADCASTSS	half<>(SB), Y6
+	VADDPS	Y6, Y5, Y5
+	VBROADCASTSS	one<>(SB), Y6
+	VADDPS	Y3, Y6, Y6
+	VMULPS	Y5, Y4, Y5
+	VADDPS	Y5, Y6, Y6               // Y6 = expKernel(r)
+
+	// Scale by 2^k by adding k to the exponent field.
+	VPSLLD	$23, Y1, Y1
+	VPADDD	Y1, Y6, Y6
+
+	// The exact results of pow for y = 1, 2, 0.5 and -1.
+	VBROADCASTSS	one<>(SB), Y1
+	VCMPPS	$0x00, Y1, Y10, Y2       // y == 1
+	VBLENDVPS	Y2, Y0, Y6, Y6
+	VBROADCASTSS	two<>(SB), Y2
+	VCMPPS	$0x00, Y2, Y10, Y2       // y == 2
+	VMULPS	Y0, Y0, Y3
+	VBLENDVPS	Y2, Y3, Y6, Y6
+	VBROADCASTSS	half<>(SB), Y2
+	VCMPPS	$0x00, Y2, Y10, Y2       // y == 0.5
+	VSQRTPS	Y0, Y3
+	VBLENDVPS	Y2, Y3, Y6, Y6
+	VBROADCASTSS	negOne<>(SB), Y2
+	VCMPPS	$0x00, Y2, Y10, Y2       // y == -1
+	VDIVPS	Y0, Y1, Y3
+	VBLENDVPS	Y2, Y3, Y6, Y6
+
+	VMOVUPS	Y6, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, R8
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	powLoop
+
+powDone:
+	VZEROUPPER
+	MOVQ	AX, ret+72(FP)
+	RET
+
+// func sqrtBlocksAVX2(dst, src []float32) int
+TEXT ·sqrtBlocksAVX2(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	XORQ	AX, AX
+
+sqrtLoop:
+	CMPQ	CX, $8
+	JLT	sqrtDone
+	VMOVUPS	(SI), Y0
+
+	// Reject negative lanes, for which VSQRTPS returns a NaN with the sign bit set
+	// instead of NaN().
+	VMOVMSKPS	Y0, DX
+	TESTL	DX, DX
+	JNE	sqrtDone
+
+	VSQRTPS	Y0, Y0
+	VMOVUPS	Y0, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	sqrtLoop
+
+sqrtDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
+
+// func floorBlocksAVX2(dst, src []float32) int
+TEXT ·floorBlocksAVX2(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	XORQ	AX, AX
+
+floorLoop:
+	CMPQ	CX, $8
+	JLT	floorDone
+	VROUNDPS	$1, (SI), Y0         // Round toward -Inf (floor), mode = 0x01
+	VMOVUPS	Y0, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	floorLoop
+
+floorDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
+
+// func ceilBlocksAVX2(dst, src []float32) int
+TEXT ·ceilBlocksAVX2(SB),NOSPLIT,$0-56
+	MOVQ	dst_base+0(FP), DI
+	MOVQ	src_base+24(FP), SI
+	MOVQ	src_len+32(FP), CX
+	XORQ	AX, AX
+
+ceilLoop:
+	CMPQ	CX, $8
+	JLT	ceilDone
+	VROUNDPS	$2, (SI), Y0         // Round toward +Inf (ceil), mode = 0x02
+	VMOVUPS	Y0, (DI)
+	ADDQ	$32, SI
+	ADDQ	$32, DI
+	SUBQ	$8, CX
+	ADDQ	$8, AX
+	JMP	ceilLoop
+
+ceilDone:
+	VZEROUPPER
+	MOVQ	AX, ret+48(FP)
+	RET
@@ -0,0 +1,82 @@
+//go:build arm64 && !purego
+
+package math32
+
+// The *BlocksNEON functions are implemented in slice_arm64.s. They process four
+// elements at a time and return the number of elements written, which is a multiple
+// of four. The polynomial kernels stop early at the first block containing an element
+// they do not handle, so that the caller can compute it with the scalar function.
+
+// sinBlocksNEON evaluates the Sin polynomials on four lanes at a time.
+func sinBlocksNEON(dst, src []float32) int
+
+// cosBlocksNEON evaluates the Cos polynomials on four lanes at a time.
+func cosBlocksNEON(dst, src []float32) int
+
+// expBlocksNEON evaluates the Exp polynomial on four lanes at a time.
+func expBlocksNEON(dst, src []float32) int
+
+// logBlocksNEON evaluates the Log polynomial on four lanes at a time.
+func logBlocksNEON(dst, src []float32) int
+
+// powBlocksNEON combines the Log and Exp kernels on four lanes at a time.
+func powBlocksNEON(dst, x, y []float32) int
+
+// sqrtBlocksNEON is implemented in slice_arm64.s using FSQRT on four lanes at a time.
+func sqrtBlocksNEON(dst, src []float32) int
+
+// floorBlocksNEON is implemented in slice_arm64.s using FRINTM on four lanes at a time.
+func floorBlocksNEON(dst, src []float32) int
+
+// ceilBlocksNEON is implemented in slice_arm64.s using FRINTP on four lanes at a time.
+func ceilBlocksNEON(dst, src []float32) int
+
+// blockSize is the number of lanes processed by the NEON kernels.
+const blockSize = 4
+
+// vectorize runs the block kernel over src, computing the blocks it rejects and the
+// remaining tail with the scalar fallback.
+func vectorize(dst, src []float32, blocks func(dst, src []float32) int, scalar func(dst, src []float32)) {
+	for i := 0; i < len(src); {
+		i += blocks(dst[i:], src[i:])
+		end := i + blockSize
+		if end > len(src) {
+			end = len(src)
+		}
+		scalar(dst[i:end], src[i:end])
+		i = end
+	}
+}
+
+func sinSlice(dst, src []float32) { vectorize(dst, src, sinBlocksNEON, sinSliceGeneric) }
+func cosSlice(dst, src []float32) { vectorize(dst, src, cosBlocksNEON, cosSliceGeneric) }
+func expSlice(dst, src []float32) { vectorize(dst, src, expBlocksNEON, expSliceGeneric) }
+func logSlice(dst, src []float32) { vectorize(dst, src, logBlocksNEON, logSliceGeneric) }
+
+// powSlice is vectorize for PowSlice, whose kernel and scalar fallback take two arguments.
+func powSlice(dst, x, y []float32) {
+	for i := 0; i < len(x); {
+		i += powBlocksNEON(dst[i:], x[i:], y[i:])
+		end := i + blockSize
+		if end > len(x) {
+			end = len(x)
+		}
+		powSliceGeneric(dst[i:end], x[i:end], y[i:end])
+		i = end
+	}
+}
+
+func sqrtSlice(dst, src []float32) {
+	n := sqrtBlocksNEON(dst, src)
+	sqrtSliceGeneric(dst[n:], src[n:])
+}
+
+func floorSlice(dst, src []float32) {
+	n := floorBlocksNEON(dst, src)
+	floorSliceGeneric(dst[n:], src[n:])
+}
+
+func ceilSlice(dst, src []float32) {
+	n := ceilBlocksNEON(dst, src)
+	ceilSliceGeneric(dst[n:], src[n:])
+}
@@ -0,0 +1,732 @@
+//go:build arm64 && !purego
+
+#include "textflag.h"
+
+// The vector instructions are encoded with WORD so that older assemblers accept them.
+// Constants are broadcast to all lanes with VDUP before the loop of each kernel.
+// The polynomial coefficients are the float32 roundings of the ones used by sinKernel,
+// cosKernel, expKernel and logKernel, and match slice_amd64.s.
+
+// Clears the sign bit
+#define absMask $0x7fffffff
+// Integer 1
+#define intOne $0x00000001
+// Integer 2
+#define intTwo $0x00000002
+// Exponent bias
+#define int127 $0x0000007f
+// 1.0
+#define one $0x3f800000
+// 2.0
+#define two $0x40000000
+// 0.5
+#define half $0x3f000000
+// -0.5
+#define negHalf $0xbf000000
+// 2^20, largest argument handled by the sin and cos kernels
+#define sinMaxArg $0x49800000
+// 2/Pi
+#define invPi2 $0x3f22f983
+// High bits of Pi/2
+#define pi2Hi $0x3fc90000
+// Low bits of Pi/2
+#define pi2Lo $0x39fdaa22
+#define sinS1 $0xbe2aaaab
+#define sinS2 $0x3c088889
+#define sinS3 $0xb9500d01
+#define sinS4 $0x3638ef1b
+#define sinS5 $0xb2d72f34
+#define cosC1 $0x3d2aaaab
+#define cosC2 $0xbab60b61
+#define cosC3 $0x37d00d01
+#define cosC4 $0xb493f27c
+#define cosC5 $0x310f74f6
+// 80, largest argument handled by the exp kernel
+#define expMaxArg $0x42a00000
+// -80, smallest argument handled by the exp kernel
+#define expMinArg $0xc2a00000
+// 1/ln(2)
+#define invLn2 $0x3fb8aa3b
+// High bits of ln(2)
+#define ln2Hi $0x3f317200
+// Low bits of ln(2)
+#define ln2Lo $0x35bfbe8e
+#define expC3 $0x3e2aaaab
+#define expC4 $0x3d2aaaab
+#define expC5 $0x3c088889
+#define expC6 $0x3ab60b61
+// Mantissa bits, also the largest subnormal
+#define mantMask $0x007fffff
+// +Inf
+#define infBits $0x7f800000
+// sqrt(2)
+#define sqrt2 $0x3fb504f3
+#define logL1 $0x3f2aaaab
+#define logL2 $0x3ecccccd
+#define logL3 $0x3e924925
+#define logL4 $0x3e638e29
+#define logL5 $0x3e3a3325
+#define logL6 $0x3e1cd04f
+
+// func sqrtBlocksNEON(dst, src []float32) int
+TEXT ·sqrtBlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	LSL	$2, R3, R4               // Number of elements processed
+	CBZ	R3, sqrtDone
+sqrtLoop:
+	VLD1.P	16(R1), [V0.S4]
+	WORD	$0x6ea1f800              // FSQRT V0.4S, V0.4S
+	VST1.P	[V0.S4], 16(R0)
+	SUB	$1, R3, R3
+	CBNZ	R3, sqrtLoop
+sqrtDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// func floorBlocksNEON(dst, src []float32) int
+TEXT ·floorBlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	LSL	$2, R3, R4               // Number of elements processed
+	CBZ	R3, floorDone
+floorLoop:
+	VLD1.P	16(R1), [V0.S4]
+	WORD	$0x4e219800              // FRINTM V0.4S, V0.4S (round toward minus infinity)
+	VST1.P	[V0.S4], 16(R0)
+	SUB	$1, R3, R3
+	CBNZ	R3, floorLoop
+floorDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// func ceilBlocksNEON(dst, src []float32) int
+TEXT ·ceilBlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	LSL	$2, R3, R4               // Number of elements processed
+	CBZ	R3, ceilDone
+ceilLoop:
+	VLD1.P	16(R1), [V0.S4]
+	WORD	$0x4ea18800              // FRINTP V0.4S, V0.4S (round toward plus infinity)
+	VST1.P	[V0.S4], 16(R0)
+	SUB	$1, R3, R3
+	CBNZ	R3, ceilLoop
+ceilDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// The polynomial kernels process whole blocks of four elements and return the number of
+// elements written. They stop at the first block holding an element outside the range
+// they handle, and evaluate the same operations in the same order as the AVX2 kernels.
+
+// func sinBlocksNEON(dst, src []float32) int
+TEXT ·sinBlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	MOVD	ZR, R4                   // Number of elements processed
+	CBZ	R3, sinDone
+
+	// Broadcast the constants to all lanes.
+	MOVW	absMask, R5
+	VDUP	R5, V16.S4
+	MOVW	sinMaxArg, R5
+	VDUP	R5, V17.S4
+	MOVW	invPi2, R5
+	VDUP	R5, V18.S4
+	MOVW	pi2Hi, R5
+	VDUP	R5, V19.S4
+	MOVW	pi2Lo, R5
+	VDUP	R5, V20.S4
+	MOVW	sinS1, R5
+	VDUP	R5, V21.S4
+	MOVW	sinS2, R5
+	VDUP	R5, V22.S4
+	MOVW	sinS3, R5
+	VDUP	R5, V23.S4
+	MOVW	sinS4, R5
+	VDUP	R5, V24.S4
+	MOVW	sinS5, R5
+	VDUP	R5, V25.S4
+	MOVW	cosC1, R5
+	VDUP	R5, V26.S4
+	MOVW	cosC2, R5
+	VDUP	R5, V27.S4
+	MOVW	cosC3, R5
+	VDUP	R5, V28.S4
+	MOVW	cosC4, R5
+	VDUP	R5, V29.S4
+	MOVW	cosC5, R5
+	VDUP	R5, V30.S4
+	MOVW	half, R5
+	VDUP	R5, V31.S4
+	MOVW	one, R5
+	VDUP	R5, V13.S4
+	MOVW	intOne, R5
+	VDUP	R5, V14.S4
+	MOVW	intTwo, R5
+	VDUP	R5, V15.S4
+
+sinLoop:
+	VLD1.P	16(R1), [V0.S4]          // V0 = x
+
+	// Split x into |x| and its sign, and reject NaNs and large arguments.
+	VAND	V16.B16, V0.B16, V1.B16  // V1 = |x|
+	VEOR	V1.B16, V0.B16, V2.B16   // V2 = sign bit of x
+	WORD	$0x6e21e624              // FCMGE V4.4S, V17.4S, V1.4S (|x| <= sinMaxArg, false for NaN)
+	VMOV	V4.D[0], R5
+	VMOV	V4.D[1], R6
+	AND	R6, R5, R5
+	CMN	$1, R5                   // All lanes set
+	BNE	sinDone
+
+	// Quadrant j and reduced argument y = |x| - j*pi2Hi - j*pi2Lo.
+	WORD	$0x6e32dc23              // FMUL V3.4S, V1.4S, V18.4S
+	WORD	$0x4ea1b863              // FCVTZS V3.4S, V3.4S (V3 = j)
+	WORD	$0x4e21d864              // SCVTF V4.4S, V3.4S (V4 = float32(j))
+	WORD	$0x6e33dc85              // FMUL V5.4S, V4.4S, V19.4S
+	WORD	$0x4ea5d425              // FSUB V5.4S, V1.4S, V5.4S
+	WORD	$0x6e34dc86              // FMUL V6.4S, V4.4S, V20.4S
+	WORD	$0x4ea6d4a5              // FSUB V5.4S, V5.4S, V6.4S (V5 = y)
+	WORD	$0x6e25dca6              // FMUL V6.4S, V5.4S, V5.4S (V6 = z = y*y)
+
+	// sinKernel: y + y*z*(S1 + z*(S2 + z*(S3 + z*(S4 + z*S5))))
+	WORD	$0x6e26df27              // FMUL V7.4S, V25.4S, V6.4S
+	WORD	$0x4e38d4e7              // FADD V7.4S, V7.4S, V24.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e37d4e7              // FADD V7.4S, V7.4S, V23.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e36d4e7              // FADD V7.4S, V7.4S, V22.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e35d4e7              // FADD V7.4S, V7.4S, V21.4S
+	WORD	$0x6e26dca8              // FMUL V8.4S, V5.4S, V6.4S
+	WORD	$0x6e27dd08              // FMUL V8.4S, V8.4S, V7.4S
+	WORD	$0x4e28d4a7              // FADD V7.4S, V5.4S, V8.4S (V7 = sinKernel(y))
+
+	// cosKernel: 1 - 0.5*z + z*z*(C1 + z*(C2 + z*(C3 + z*(C4 + z*C5))))
+	WORD	$0x6e26dfc8              // FMUL V8.4S, V30.4S, V6.4S
+	WORD	$0x4e3dd508              // FADD V8.4S, V8.4S, V29.4S
+	WORD	$0x6e26dd08              // FMUL V8.4S, V8.4S, V6.4S
+	WORD	$0x4e3cd508              // FADD V8.4S, V8.4S, V28.4S
+	WORD	$0x6e26dd08              // FMUL V8.4S, V8.4S, V6.4S
+	WORD	$0x4e3bd508              // FADD V8.4S, V8.4S, V27.4S
+	WORD	$0x6e26dd08              // FMUL V8.4S, V8.4S, V6.4S
+	WORD	$0x4e3ad508              // FADD V8.4S, V8.4S, V26.4S
+	WORD	$0x6e26dcc9              // FMUL V9.4S, V6.4S, V6.4S
+	WORD	$0x6e28dd28              // FMUL V8.4S, V9.4S, V8.4S
+	WORD	$0x6e26dfe9              // FMUL V9.4S, V31.4S, V6.4S
+	WORD	$0x4ea9d5aa              // FSUB V10.4S, V13.4S, V9.4S
+	WORD	$0x4e28d548              // FADD V8.4S, V10.4S, V8.4S (V8 = cosKernel(y))
+
+	// Odd quadrants use the cosine kernel.
+	VAND	V14.B16, V3.B16, V10.B16
+	VCMEQ	V14.S4, V10.S4, V10.S4
+	VBSL	V7.B16, V8.B16, V10.B16
+
+	// Quadrants 2 and 3 flip the sign, as does a negative x.
+	VAND	V15.B16, V3.B16, V11.B16
+	VSHL	$30, V11.S4, V11.S4
+	VEOR	V2.B16, V11.B16, V11.B16
+	VEOR	V11.B16, V10.B16, V10.B16
+
+	VST1.P	[V10.S4], 16(R0)
+	ADD	$4, R4, R4
+	SUB	$1, R3, R3
+	CBNZ	R3, sinLoop
+sinDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// func cosBlocksNEON(dst, src []float32) int
+TEXT ·cosBlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	MOVD	ZR, R4                   // Number of elements processed
+	CBZ	R3, cosDone
+
+	// Broadcast the constants to all lanes.
+	MOVW	absMask, R5
+	VDUP	R5, V16.S4
+	MOVW	sinMaxArg, R5
+	VDUP	R5, V17.S4
+	MOVW	invPi2, R5
+	VDUP	R5, V18.S4
+	MOVW	pi2Hi, R5
+	VDUP	R5, V19.S4
+	MOVW	pi2Lo, R5
+	VDUP	R5, V20.S4
+	MOVW	sinS1, R5
+	VDUP	R5, V21.S4
+	MOVW	sinS2, R5
+	VDUP	R5, V22.S4
+	MOVW	sinS3, R5
+	VDUP	R5, V23.S4
+	MOVW	sinS4, R5
+	VDUP	R5, V24.S4
+	MOVW	sinS5, R5
+	VDUP	R5, V25.S4
+	MOVW	cosC1, R5
+	VDUP	R5, V26.S4
+	MOVW	cosC2, R5
+	VDUP	R5, V27.S4
+	MOVW	cosC3, R5
+	VDUP	R5, V28.S4
+	MOVW	cosC4, R5
+	VDUP	R5, V29.S4
+	MOVW	cosC5, R5
+	VDUP	R5, V30.S4
+	MOVW	half, R5
+	VDUP	R5, V31.S4
+	MOVW	one, R5
+	VDUP	R5, V13.S4
+	MOVW	intOne, R5
+	VDUP	R5, V14.S4
+	MOVW	intTwo, R5
+	VDUP	R5, V15.S4
+
+cosLoop:
+	VLD1.P	16(R1), [V0.S4]          // V0 = x
+
+	// Cosine is even, so only |x| matters. Reject NaNs and large arguments.
+	VAND	V16.B16, V0.B16, V1.B16  // V1 = |x|
+	WORD	$0x6e21e624              // FCMGE V4.4S, V17.4S, V1.4S (|x| <= sinMaxArg, false for NaN)
+	VMOV	V4.D[0], R5
+	VMOV	V4.D[1], R6
+	AND	R6, R5, R5
+	CMN	$1, R5                   // All lanes set
+	BNE	cosDone
+
+	// Quadrant j and reduced argument y = |x| - j*pi2Hi - j*pi2Lo.
+	WORD	$0x6e32dc23              // FMUL V3.4S, V1.4S, V18.4S
+	WORD	$0x4ea1b863              // FCVTZS V3.4S, V3.4S (V3 = j)
+	WORD	$0x4e21d864              // SCVTF V4.4S, V3.4S (V4 = float32(j))
+	WORD	$0x6e33dc85              // FMUL V5.4S, V4.4S, V19.4S
+	WORD	$0x4ea5d425              // FSUB V5.4S, V1.4S, V5.4S
+	WORD	$0x6e34dc86              // FMUL V6.4S, V4.4S, V20.4S
+	WORD	$0x4ea6d4a5              // FSUB V5.4S, V5.4S, V6.4S (V5 = y)
+	WORD	$0x6e25dca6              // FMUL V6.4S, V5.4S, V5.4S (V6 = z = y*y)
+
+	// sinKernel: y + y*z*(S1 + z*(S2 + z*(S3 + z*(S4 + z*S5))))
+	WORD	$0x6e26df27              // FMUL V7.4S, V25.4S, V6.4S
+	WORD	$0x4e38d4e7              // FADD V7.4S, V7.4S, V24.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e37d4e7              // FADD V7.4S, V7.4S, V23.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e36d4e7              // FADD V7.4S, V7.4S, V22.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e35d4e7              // FADD V7.4S, V7.4S, V21.4S
+	WORD	$0x6e26dca8              // FMUL V8.4S, V5.4S, V6.4S
+	WORD	$0x6e27dd08              // FMUL V8.4S, V8.4S, V7.4S
+	WORD	$0x4e28d4a7              // FADD V7.4S, V5.4S, V8.4S (V7 = sinKernel(y))
+
+	// cosKernel: 1 - 0.5*z + z*z*(C1 + z*(C2 + z*(C3 + z*(C4 + z*C5))))
+	WORD	$0x6e26dfc8              // FMUL V8.4S, V30.4S, V6.4S
+	WORD	$0x4e3dd508              // FADD V8.4S, V8.4S, V29.4S
+	WORD	$0x6e26dd08              // FMUL V8.4S, V8.4S, V6.4S
+	WORD	$0x4e3cd508              // FADD V8.4S, V8.4S, V28.4S
+	WORD	$0x6e26dd08              // FMUL V8.4S, V8.4S, V6.4S
+	WORD	$0x4e3bd508              // FADD V8.4S, V8.4S, V27.4S
+	WORD	$0x6e26dd08              // FMUL V8.4S, V8.4S, V6.4S
+	WORD	$0x4e3ad508              // FADD V8.4S, V8.4S, V26.4S
+	WORD	$0x6e26dcc9              // FMUL V9.4S, V6.4S, V6.4S
+	WORD	$0x6e28dd28              // FMUL V8.4S, V9.4S, V8.4S
+	WORD	$0x6e26dfe9              // FMUL V9.4S, V31.4S, V6.4S
+	WORD	$0x4ea9d5aa              // FSUB V10.4S, V13.4S, V9.4S
+	WORD	$0x4e28d548              // FADD V8.4S, V10.4S, V8.4S (V8 = cosKernel(y))
+
+	// Odd quadrants use the sine kernel.
+	VAND	V14.B16, V3.B16, V10.B16
+	VCMEQ	V14.S4, V10.S4, V10.S4
+	VBSL	V8.B16, V7.B16, V10.B16
+
+	// Quadrants 1 and 2 flip the sign.
+	VADD	V14.S4, V3.S4, V11.S4
+	VAND	V15.B16, V11.B16, V11.B16
+	VSHL	$30, V11.S4, V11.S4
+	VEOR	V11.B16, V10.B16, V10.B16
+
+	VST1.P	[V10.S4], 16(R0)
+	ADD	$4, R4, R4
+	SUB	$1, R3, R3
+	CBNZ	R3, cosLoop
+cosDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// func expBlocksNEON(dst, src []float32) int
+TEXT ·expBlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	MOVD	ZR, R4                   // Number of elements processed
+	CBZ	R3, expDone
+
+	// Broadcast the constants to all lanes.
+	MOVW	expMaxArg, R5
+	VDUP	R5, V16.S4
+	MOVW	expMinArg, R5
+	VDUP	R5, V17.S4
+	MOVW	invLn2, R5
+	VDUP	R5, V18.S4
+	MOVW	half, R5
+	VDUP	R5, V19.S4
+	MOVW	negHalf, R5
+	VDUP	R5, V20.S4
+	MOVW	ln2Hi, R5
+	VDUP	R5, V21.S4
+	MOVW	ln2Lo, R5
+	VDUP	R5, V22.S4
+	MOVW	expC3, R5
+	VDUP	R5, V23.S4
+	MOVW	expC4, R5
+	VDUP	R5, V24.S4
+	MOVW	expC5, R5
+	VDUP	R5, V25.S4
+	MOVW	expC6, R5
+	VDUP	R5, V26.S4
+	MOVW	one, R5
+	VDUP	R5, V27.S4
+
+expLoop:
+	VLD1.P	16(R1), [V0.S4]          // V0 = x
+
+	// Reject NaNs and arguments whose result could overflow or be subnormal.
+	WORD	$0x6e20e601              // FCMGE V1.4S, V16.4S, V0.4S (x <= expMaxArg)
+	WORD	$0x6e31e402              // FCMGE V2.4S, V0.4S, V17.4S (x >= expMinArg)
+	VAND	V2.B16, V1.B16, V1.B16
+	VMOV	V1.D[0], R5
+	VMOV	V1.D[1], R6
+	AND	R6, R5, R5
+	CMN	$1, R5                   // All lanes set
+	BNE	expDone
+
+	// k = int32(x*invLn2 + 0.5), or int32(x*invLn2 - 0.5) for negative x.
+	WORD	$0x6e32dc01              // FMUL V1.4S, V0.4S, V18.4S
+	WORD	$0x4ea0e803              // FCMLT V3.4S, V0.4S, #0 (x < 0)
+	VBSL	V19.B16, V20.B16, V3.B16
+	WORD	$0x4e23d421              // FADD V1.4S, V1.4S, V3.4S
+	WORD	$0x4ea1b821              // FCVTZS V1.4S, V1.4S (V1 = k)
+	WORD	$0x4e21d822              // SCVTF V2.4S, V1.4S (V2 = float32(k))
+
+	// r = (x - k*ln2Hi) - k*ln2Lo
+	WORD	$0x6e35dc43              // FMUL V3.4S, V2.4S, V21.4S
+	WORD	$0x4ea3d403              // FSUB V3.4S, V0.4S, V3.4S
+	WORD	$0x6e36dc44              // FMUL V4.4S, V2.4S, V22.4S
+	WORD	$0x4ea4d463              // FSUB V3.4S, V3.4S, V4.4S (V3 = r)
+	WORD	$0x6e23dc64              // FMUL V4.4S, V3.4S, V3.4S (V4 = r*r)
+
+	// expKernel: 1 + r + r*r*(c2 + r*(c3 + r*(c4 + r*(c5 + r*c6))))
+	WORD	$0x6e23df45              // FMUL V5.4S, V26.4S, V3.4S
+	WORD	$0x4e39d4a5              // FADD V5.4S, V5.4S, V25.4S
+	WORD	$0x6e23dca5              // FMUL V5.4S, V5.4S, V3.4S
+	WORD	$0x4e38d4a5              // FADD V5.4S, V5.4S, V24.4S
+	WORD	$0x6e23dca5              // FMUL V5.4S, V5.4S, V3.4S
+	WORD	$0x4e37d4a5              // FADD V5.4S, V5.4S, V23.4S
+	WORD	$0x6e23dca5              // FMUL V5.4S, V5.4S, V3.4S
+	WORD	$0x4e33d4a5              // FADD V5.4S, V5.4S, V19.4S
+	WORD	$0x4e23d766              // FADD V6.4S, V27.4S, V3.4S
+	WORD	$0x6e25dc85              // FMUL V5.4S, V4.4S, V5.4S
+	WORD	$0x4e25d4c6              // FADD V6.4S, V6.4S, V5.4S (V6 = expKernel(r))
+
+	// Scale by 2^k by adding k to the exponent field.
+	VSHL	$23, V1.S4, V1.S4
+	VADD	V1.S4, V6.S4, V6.S4
+
+	VST1.P	[V6.S4], 16(R0)
+	ADD	$4, R4, R4
+	SUB	$1, R3, R3
+	CBNZ	R3, expLoop
+expDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// func logBlocksNEON(dst, src []float32) int
+TEXT ·logBlocksNEON(SB),NOSPLIT,$0-56
+	MOVD	dst_base+0(FP), R0
+	MOVD	src_base+24(FP), R1
+	MOVD	src_len+32(FP), R2
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	MOVD	ZR, R4                   // Number of elements processed
+	CBZ	R3, logDone
+
+	// Broadcast the constants to all lanes.
+	MOVW	mantMask, R5
+	VDUP	R5, V16.S4
+	MOVW	infBits, R5
+	VDUP	R5, V17.S4
+	MOVW	int127, R5
+	VDUP	R5, V18.S4
+	MOVW	one, R5
+	VDUP	R5, V19.S4
+	MOVW	sqrt2, R5
+	VDUP	R5, V20.S4
+	MOVW	half, R5
+	VDUP	R5, V21.S4
+	MOVW	logL1, R5
+	VDUP	R5, V22.S4
+	MOVW	logL2, R5
+	VDUP	R5, V23.S4
+	MOVW	logL3, R5
+	VDUP	R5, V24.S4
+	MOVW	logL4, R5
+	VDUP	R5, V25.S4
+	MOVW	logL5, R5
+	VDUP	R5, V26.S4
+	MOVW	logL6, R5
+	VDUP	R5, V27.S4
+	MOVW	two, R5
+	VDUP	R5, V28.S4
+	MOVW	ln2Hi, R5
+	VDUP	R5, V29.S4
+	MOVW	ln2Lo, R5
+	VDUP	R5, V30.S4
+
+logLoop:
+	VLD1.P	16(R1), [V0.S4]          // V0 = x
+
+	// Only positive, normal and finite x are handled.
+	// Negative values have the sign bit set and compare below mantMask.
+	WORD	$0x4eb03402              // CMGT V2.4S, V0.4S, V16.4S (bits(x) > largest subnormal)
+	WORD	$0x4ea03623              // CMGT V3.4S, V17.4S, V0.4S (bits(x) < +Inf)
+	VAND	V3.B16, V2.B16, V2.B16
+	VMOV	V2.D[0], R5
+	VMOV	V2.D[1], R6
+	AND	R6, R5, R5
+	CMN	$1, R5                   // All lanes set
+	BNE	logDone
+
+	// Unbiased exponent k and mantissa f in [1, 2).
+	VUSHR	$23, V0.S4, V1.S4
+	VSUB	V18.S4, V1.S4, V1.S4     // V1 = k
+	VAND	V16.B16, V0.B16, V2.B16
+	VORR	V19.B16, V2.B16, V2.B16  // V2 = f
+
+	// Reduce f to [sqrt(2)/2, sqrt(2)].
+	WORD	$0x6eb4e444              // FCMGT V4.4S, V2.4S, V20.4S (f > sqrt(2))
+	WORD	$0x6e35dc45              // FMUL V5.4S, V2.4S, V21.4S
+	VBIT	V4.B16, V5.B16, V2.B16
+	VSUB	V4.S4, V1.S4, V1.S4      // k++ where the mask is all ones
+
+	// s = (f - 1) / (f + 1)
+	WORD	$0x4eb3d445              // FSUB V5.4S, V2.4S, V19.4S
+	WORD	$0x4e33d446              // FADD V6.4S, V2.4S, V19.4S
+	WORD	$0x6e26fca5              // FDIV V5.4S, V5.4S, V6.4S (V5 = s)
+	WORD	$0x6e25dca6              // FMUL V6.4S, V5.4S, V5.4S (V6 = s*s)
+
+	// logKernel: s*(2 + s2*(L1 + s2*(L2 + s2*(L3 + s2*(L4 + s2*(L5 + s2*L6))))))
+	WORD	$0x6e26df67              // FMUL V7.4S, V27.4S, V6.4S
+	WORD	$0x4e3ad4e7              // FADD V7.4S, V7.4S, V26.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e39d4e7              // FADD V7.4S, V7.4S, V25.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e38d4e7              // FADD V7.4S, V7.4S, V24.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e37d4e7              // FADD V7.4S, V7.4S, V23.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e36d4e7              // FADD V7.4S, V7.4S, V22.4S
+	WORD	$0x6e27dcc7              // FMUL V7.4S, V6.4S, V7.4S
+	WORD	$0x4e3cd4e7              // FADD V7.4S, V7.4S, V28.4S
+	WORD	$0x6e27dca7              // FMUL V7.4S, V5.4S, V7.4S (V7 = logKernel(s))
+
+	// k*ln2Hi + (k*ln2Lo + logKernel(s))
+	WORD	$0x4e21d821              // SCVTF V1.4S, V1.4S
+	WORD	$0x6e3edc28              // FMUL V8.4S, V1.4S, V30.4S
+	WORD	$0x4e27d508              // FADD V8.4S, V8.4S, V7.4S
+	WORD	$0x6e3ddc29              // FMUL V9.4S, V1.4S, V29.4S
+	WORD	$0x4e28d529              // FADD V9.4S, V9.4S, V8.4S
+
+	VST1.P	[V9.S4], 16(R0)
+	ADD	$4, R4, R4
+	SUB	$1, R3, R3
+	CBNZ	R3, logLoop
+logDone:
+	MOVD	R4, ret+48(FP)
+	RET
+
+// The pow kernel keeps 21 constants in registers, so it derives -0.5 and -1 with FNEG and
+// checks the range of y*Log(x) on its absolute value.
+
+// func powBlocksNEON(dst, x, y []float32) int
+TEXT ·powBlocksNEON(SB),NOSPLIT,$0-80
+	MOVD	dst_base+0(FP), R0
+	MOVD	x_base+24(FP), R1
+	MOVD	x_len+32(FP), R2
+	MOVD	y_base+48(FP), R7
+	LSR	$2, R2, R3               // Number of 4-lane blocks
+	MOVD	ZR, R4                   // Number of elements processed
+	CBZ	R3, powDone
+
+	// Broadcast the constants to all lanes.
+	MOVW	mantMask, R5
+	VDUP	R5, V11.S4
+	MOVW	infBits, R5
+	VDUP	R5, V12.S4
+	MOVW	int127, R5
+	VDUP	R5, V13.S4
+	MOVW	one, R5
+	VDUP	R5, V14.S4
+	MOVW	sqrt2, R5
+	VDUP	R5, V15.S4
+	MOVW	half, R5
+	VDUP	R5, V16.S4
+	MOVW	logL1, R5
+	VDUP	R5, V17.S4
+	MOVW	logL2, R5
+	VDUP	R5, V18.S4
+	MOVW	logL3, R5
+	VDUP	R5, V19.S4
+	MOVW	logL4, R5
+	VDUP	R5, V20.S4
+	MOVW	logL5, R5
+	VDUP	R5, V21.S4
+	MOVW	logL6, R5
+	VDUP	R5, V22.S4
+	MOVW	two, R5
+	VDUP	R5, V23.S4
+	MOVW	ln2Hi, R5
+	VDUP	R5, V24.S4
+	MOVW	ln2Lo, R5
+	VDUP	R5, V25.S4
+	MOVW	expMaxArg, R5
+	VDUP	R5, V26.S4
+	MOVW	invLn2, R5
+	VDUP	R5, V27.S4
+	MOVW	expC3, R5
+	VDUP	R5, V28.S4
+	MOVW	expC4, R5
+	VDUP	R5, V29.S4
+	MOVW	expC5, R5
+	VDUP	R5, V30.S4
+	MOVW	expC6, R5
+	VDUP	R5, V31.S4
+
+powLoop:
+	VLD1.P	16(R1), [V0.S4]          // V0 = x
+	VLD1.P	16(R7), [V10.S4]         // V10 = y
+
+	// Only positive, normal and finite x are handled.
+	// Negative values have the sign bit set and compare below mantMask.
+	WORD	$0x4eab3402              // CMGT V2.4S, V0.4S, V11.4S (bits(x) > largest subnormal)
+	WORD	$0x4ea03583              // CMGT V3.4S, V12.4S, V0.4S (bits(x) < +Inf)
+	VAND	V3.B16, V2.B16, V2.B16
+	VMOV	V2.D[0], R5
+	VMOV	V2.D[1], R6
+	AND	R6, R5, R5
+	CMN	$1, R5                   // All lanes set
+	BNE	powDone
+
+	// Unbiased exponent k and mantissa f in [1, 2).
+	VUSHR	$23, V0.S4, V1.S4
+	VSUB	V13.S4, V1.S4, V1.S4     // V1 = k
+	VAND	V11.B16, V0.B16, V2.B16
+	VORR	V14.B16, V2.B16, V2.B16  // V2 = f
+
+	// Reduce f to [sqrt(2)/2, sqrt(2)].
+	WORD	$0x6eafe444              // FCMGT V4.4S, V2.4S, V15.4S (f > sqrt(2))
+	WORD	$0x6e30dc45              // FMUL V5.4S, V2.4S, V16.4S
+	VBIT	V4.B16, V5.B16, V2.B16
+	VSUB	V4.S4, V1.S4, V1.S4      // k++ where the mask is all ones
+
+	// s = (f - 1) / (f + 1)
+	WORD	$0x4eaed445              // FSUB V5.4S, V2.4S, V14.4S
+	WORD	$0x4e2ed446              // FADD V6.4S, V2.4S, V14.4S
+	WORD	$0x6e26fca5              // FDIV V5.4S, V5.4S, V6.4S (V5 = s)
+	WORD	$0x6e25dca6              // FMUL V6.4S, V5.4S, V5.4S (V6 = s*s)
+
+	// logKernel: s*(2 + s2*(L1 + s2*(L2 + s2*(L3 + s2*(L4 + s2*(L5 + s2*L6))))))
+	WORD	$0x6e26dec7              // FMUL V7.4S, V22.4S, V6.4S
+	WORD	$0x4e35d4e7              // FADD V7.4S, V7.4S, V21.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e34d4e7              // FADD V7.4S, V7.4S, V20.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e33d4e7              // FADD V7.4S, V7.4S, V19.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e32d4e7              // FADD V7.4S, V7.4S, V18.4S
+	WORD	$0x6e26dce7              // FMUL V7.4S, V7.4S, V6.4S
+	WORD	$0x4e31d4e7              // FADD V7.4S, V7.4S, V17.4S
+	WORD	$0x6e27dcc7              // FMUL V7.4S, V6.4S, V7.4S
+	WORD	$0x4e37d4e7              // FADD V7.4S, V7.4S, V23.4S
+	WORD	$0x6e27dca7              // FMUL V7.4S, V5.4S, V7.4S (V7 = logKernel(s))
+
+	// k*ln2Hi + (k*ln2Lo + logKernel(s))
+	WORD	$0x4e21d821              // SCVTF V1.4S, V1.4S
+	WORD	$0x6e39dc28              // FMUL V8.4S, V1.4S, V25.4S
+	WORD	$0x4e27d508              // FADD V8.4S, V8.4S, V7.4S
+	WORD	$0x6e38dc29              // FMUL V9.4S, V1.4S, V24.4S
+	WORD	$0x4e28d529              // FADD V9.4S, V9.4S, V8.4S
+
+	// Reject products outside the range of the exp kernel, including NaNs.
+	WORD	$0x6e29dd49              // FMUL V9.4S, V10.4S, V9.4S (V9 = p = y*Log(x))
+	WORD	$0x4ea0f921              // FABS V1.4S, V9.4S
+	WORD	$0x6e21e741              // FCMGE V1.4S, V26.4S, V1.4S (|p| <= expMaxArg)
+	VMOV	V1.D[0], R5
+	VMOV	V1.D[1], R6
+	AND	R6, R5, R5
+	CMN	$1, R5                   // All lanes set
+	BNE	powDone
+
+	// k = int32(p*invLn2 + 0.5), or int32(p*invLn2 - 0.5) for negative p.
+	WORD	$0x6e3bdd21              // FMUL V1.4S, V9.4S, V27.4S
+	WORD	$0x4ea0e923              // FCMLT V3.4S, V9.4S, #0 (p < 0)
+	WORD	$0x6ea0fa02              // FNEG V2.4S, V16.4S (V2 = -0.5)
+	VBSL	V16.B16, V2.B16, V3.B16
+	WORD	$0x4e23d421              // FADD V1.4S, V1.4S, V3.4S
+	WORD	$0x4ea1b821              // FCVTZS V1.4S, V1.4S (V1 = k)
+	WORD	$0x4e21d822              // SCVTF V2.4S, V1.4S (V2 = float32(k))
+
+	// r = (p - k*ln2Hi) - k*ln2Lo
+	WORD	$0x6e38dc43              // FMUL V3.4S, V2.4S, V24.4S
+	WORD	$0x4ea3d523              // FSUB V3.4S, V9.4S, V3.4S
+	WORD	$0x6e39dc44              // FMUL V4.4S, V2.4S, V25.4S
+	WORD	$0x4ea4d463              // FSUB V3.4S, V3.4S, V4.4S (V3 = r)
+	WORD	$0x6e23dc64              // FMUL V4.4S, V3.4S, V3.4S (V4 = r*r)
+
+	// expKernel: 1 + r + r*r*(c2 + r*(c3 + r*(c4 + r*(c5 + r*c6))))
+	WORD	$0x6e23dfe5              // FMUL V5.4S, V31.4S, V3.4S
+	WORD	$0x4e3ed4a5              // FADD V5.4S, V5.4S, V30.4S
+	WORD	$0x6e23dca5              // FMUL V5.4S, V5.4S, V3.4S
+	WORD	$0x4e3dd4a5              // FADD V5.4S, V5.4S, V29.4S
+	WORD	$0x6e23dca5              // FMUL V5.4S, V5.4S, V3.4S
+	WORD	$0x4e3cd4a5              // FADD V5.4S, V5.4S, V28.4S
+	WORD	$0x6e23dca5              // FMUL V5.4S, V5.4S, V3.4S
+	WORD	$0x4e30d4a5              // FADD V5.4S, V5.4S, V16.4S
+	WORD	$0x4e23d5c6              // FADD V6.4S, V14.4S, V3.4S
+	WORD	$0x6e25dc85              // FMUL V5.4S, V4.4S, V5.4S
+	WORD	$0x4e25d4c6              // FADD V6.4S, V6.4S, V5.4S (V6 = expKernel(r))
+
+	// Scale by 2^k by adding k to the exponent field.
+	VSHL	$23, V1.S4, V1.S4
+	VADD	V1.S4, V6.S4, V6.S4
+
+	// The exact results of pow for y = 1, 2, 0.5 and -1.
+	WORD	$0x4e2ee541              // FCMEQ V1.4S, V10.4S, V14.4S (y == 1)
+	VBIT	V1.B16, V0.B16, V6.B16
+	WORD	$0x4e37e541              // FCMEQ V1.4S, V10.4S, V23.4S (y == 2)
+	WORD	$0x6e20dc02              // FMUL V2.4S, V0.4S, V0.4S
+	VBIT	V1.B16, V2.B16, V6.B16
+	WORD	$0x4e30e541              // FCMEQ V1.4S, V10.4S, V16.4S (y == 0.5)
+	WORD	$0x6ea1f802              // FSQRT V2.4S, V0.4S
+	VBIT	V1.B16, V2.B16, V6.B16
+	WORD	$0x6ea0f942              // FNEG V2.4S, V10.4S
+	WORD	$0x4e2ee441              // FCMEQ V1.4S, V2.4S, V14.4S (y == -1)
+	WORD	$0x6e20fdc2              // FDIV V2.4S, V14.4S, V0.4S
+	VBIT	V1.B16, V2.B16, V6.B16
+
+	VST1.P	[V6.S4], 16(R0)
+	ADD	$4, R4, R4
+	SUB	$1, R3, R3
+	CBNZ	R3, powLoop
+powDone:
+	MOVD	R4, ret+72(FP)
+	RET
@@ -0,0 +1,14 @@
+//go:build !amd64.v3 && !arm64
+
+package math32
+
+// kernelClose reports whether a vector kernel result is acceptable when it is not identical
+// to the scalar one. Without fused multiply-adds both evaluate exactly the same operations.
+func kernelClose(got, want float32) bool {
+	return false
+}
+
+// powKernelClose is kernelClose for Pow(x, y).
+func powKernelClose(got, want, x, y float32) bool {
+	return false
+}
@@ -0,0 +1,15 @@
+//go:build amd64.v3 || arm64
+
+package math32
+
+// kernelClose reports whether a vector kernel result is acceptable when it is not identical
+// to the scalar one, which the compiler builds with fused multiply-adds.
+func kernelClose(got, want float32) bool {
+	return ulpDiff(got, want) <= 2 || Abs(got-want) <= 0x1p-22
+}
+
+// powKernelClose is kernelClose for Pow(x, y). Exp amplifies the difference between the
+// two logarithms by |y·ln x|.
+func powKernelClose(got, want, x, y float32) bool {
+	return float32(ulpDiff(got, want)) <= 2+4*Abs(y*Log(x))
+}
@@ -0,0 +1,14 @@
+//go:build (!amd64 && !arm64) || purego
+
+package math32
+
+// Architectures without vector kernels, and purego builds, use the pure Go loops.
+
+func sinSlice(dst, src []float32)   { sinSliceGeneric(dst, src) }
+func cosSlice(dst, src []float32)   { cosSliceGeneric(dst, src) }
+func expSlice(dst, src []float32)   { expSliceGeneric(dst, src) }
+func logSlice(dst, src []float32)   { logSliceGeneric(dst, src) }
+func powSlice(dst, x, y []float32)  { powSliceGeneric(dst, x, y) }
+func sqrtSlice(dst, src []float32)  { sqrtSliceGeneric(dst, src) }
+func floorSlice(dst, src []float32) { floorSliceGeneric(dst, src) }
+func ceilSlice(dst, src []float32)  { ceilSliceGeneric(dst, src) }
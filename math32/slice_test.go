@@ -0,0 +1,164 @@
+package math32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// sliceInputs returns n random values in [lo, hi) interleaved with special values, so that
+// vector blocks holding elements the kernels reject are exercised too.
+func sliceInputs(n int, lo, hi float32) []float32 {
+	random := rand.New(rand.NewSource(1))
+	special := []float32{0, float32(math.Copysign(0, -1)), Inf(1), Inf(-1), NaN(), 1, -1, 0.5, -0.5,
+		1e-40, -1e-40, MaxFloat32, -MaxFloat32, SmallestNonzeroFloat32, 1e7, -1e7, 200, -200}
+
+	src := make([]float32, n)
+	for i := range src {
+		if i%97 == 13 {
+			src[i] = special[(i/97)%len(special)]
+			continue
+		}
+		src[i] = lo + (hi-lo)*random.Float32()
+	}
+	return append(src, special...)
+}
+
+func TestSliceMatchesScalar(t *testing.T) {
+	testData := []struct {
+		name   string
+		slice  func(dst, src []float32)
+		scalar func(x float32) float32
+		lo, hi float32
+		// kernel marks the functions whose vector kernels evaluate polynomials.
+		kernel bool
+	}{
+		{name: "Sin", slice: SinSlice, scalar: Sin, lo: -100, hi: 100, kernel: true},
+		{name: "Cos", slice: CosSlice, scalar: Cos, lo: -100, hi: 100, kernel: true},
+		{name: "Exp", slice: ExpSlice, scalar: Exp, lo: -90, hi: 90, kernel: true},
+		{name: "Log", slice: LogSlice, scalar: Log, lo: 0, hi: 1e6, kernel: true},
+		{name: "Sqrt", slice: SqrtSlice, scalar: Sqrt, lo: -10, hi: 1e6},
+		{name: "Floor", slice: FloorSlice, scalar: Floor, lo: -1e4, hi: 1e4},
+		{name: "Ceil", slice: CeilSlice, scalar: Ceil, lo: -1e4, hi: 1e4},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			src := sliceInputs(10000, test.lo, test.hi)
+			// Every length up to a few blocks exercises the tail handling.
+			for _, n := range []int{0, 1, 7, 8, 9, 15, 16, 17, 31, len(src)} {
+				dst := make([]float32, n)
+				test.slice(dst, src[:n])
+				for i, x := range src[:n] {
+					want := test.scalar(x)
+					if !(ulpDiff(dst[i], want) == 0 || test.kernel && kernelClose(dst[i], want)) {
+						t.Fatalf("%sSlice()[%d] = %v (%#x) for %v, want %v (%#x)",
+							test.name, i, dst[i], math.Float32bits(dst[i]), x, want, math.Float32bits(want))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSliceInPlace(t *testing.T) {
+	src := sliceInputs(100, -10, 10)
+	want := make([]float32, len(src))
+	SinSlice(want, src)
+
+	SinSlice(src, src)
+	for i := range src {
+		if ulpDiff(src[i], want[i]) != 0 {
+			t.Fatalf("in-place SinSlice()[%d] = %v, want %v", i, src[i], want[i])
+		}
+	}
+}
+
+func TestPowSlice(t *testing.T) {
+	x := sliceInputs(1000, 0, 10)
+	y := sliceInputs(1000, -4, 4)
+	// Every fifth element uses one of the exponents that pow computes exactly.
+	for i := 0; i < len(y); i += 5 {
+		y[i] = []float32{1, 2, 0.5, -1}[i/5%4]
+	}
+
+	for _, n := range []int{0, 1, 7, 8, 9, 15, 16, 17, 31, len(x)} {
+		dst := make([]float32, n)
+		PowSlice(dst, x[:n], y[:n])
+		for i := range dst {
+			want := Pow(x[i], y[i])
+			if !(ulpDiff(dst[i], want) == 0 || powKernelClose(dst[i], want, x[i], y[i])) {
+				t.Fatalf("PowSlice()[%d] = %v (%#x) for %v, %v, want %v (%#x)",
+					i, dst[i], math.Float32bits(dst[i]), x[i], y[i], want, math.Float32bits(want))
+			}
+		}
+	}
+}
+
+func TestSliceShortDstPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("SinSlice() with a short dst did not panic")
+		}
+	}()
+	SinSlice(make([]float32, 3), make([]float32, 4))
+}
+
+func benchmarkSlice(b *testing.B, slice func(dst, src []float32), scalar func(float32) float32, lo, hi float32) {
+	random := rand.New(rand.NewSource(1))
+	src := make([]float32, 4096)
+	for i := range src {
+		src[i] = lo + (hi-lo)*random.Float32()
+	}
+	dst := make([]float32, len(src))
+
+	b.Run("Scalar", func(b *testing.B) {
+		b.SetBytes(int64(4 * len(src)))
+		for i := 0; i < b.N; i++ {
+			for j, x := range src {
+				dst[j] = scalar(x)
+			}
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		b.SetBytes(int64(4 * len(src)))
+		for i := 0; i < b.N; i++ {
+			slice(dst, src)
+		}
+	})
+}
+
+func BenchmarkPowSlice(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	x := make([]float32, 4096)
+	y := make([]float32, len(x))
+	for i := range x {
+		x[i] = 10 * random.Float32()
+		y[i] = 8*random.Float32() - 4
+	}
+	dst := make([]float32, len(x))
+
+	b.Run("Scalar", func(b *testing.B) {
+		b.SetBytes(int64(4 * len(x)))
+		for i := 0; i < b.N; i++ {
+			for j := range x {
+				dst[j] = Pow(x[j], y[j])
+			}
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		b.SetBytes(int64(4 * len(x)))
+		for i := 0; i < b.N; i++ {
+			PowSlice(dst, x, y)
+		}
+	})
+}
+
+func BenchmarkSinSlice(b *testing.B)   { benchmarkSlice(b, SinSlice, Sin, -10, 10) }
+func BenchmarkCosSlice(b *testing.B)   { benchmarkSlice(b, CosSlice, Cos, -10, 10) }
+func BenchmarkExpSlice(b *testing.B)   { benchmarkSlice(b, ExpSlice, Exp, -10, 10) }
+func BenchmarkLogSlice(b *testing.B)   { benchmarkSlice(b, LogSlice, Log, 0, 1000) }
+func BenchmarkSqrtSlice(b *testing.B)  { benchmarkSlice(b, SqrtSlice, Sqrt, 0, 1000) }
+func BenchmarkFloorSlice(b *testing.B) { benchmarkSlice(b, FloorSlice, Floor, -1000, 1000) }
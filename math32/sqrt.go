@@ -4,14 +4,26 @@ import "math"
 
 // Sqrt returns the square root of x.
 //
-// This function uses float64 sqrt with conversion, which is faster than
-// native float32 sqrt on most modern hardware (especially ARM64).
+// Special cases are:
 //
-// Note: Special cases (NaN, Inf, negative values) are not explicitly handled
-// for performance reasons. The behavior for these cases is determined by the
-// underlying math.Sqrt implementation.
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+//
+// A NaN argument is returned unchanged, and negative arguments return NaN().
+// The result is correctly rounded.
 func Sqrt(x float32) float32 {
-	// Use float64 sqrt and convert back - benchmarks show this is ~7x faster
-	// on ARM64 and competitive on AMD64
+	// Handle special cases explicitly, since the hardware NaN for a negative
+	// argument differs between architectures. NaNs fail the comparison too.
+	if !(x >= 0) {
+		if IsNaN(x) {
+			return x
+		}
+		return NaN()
+	}
+
+	// The compiler turns the float64 square root of a float32 into the single
+	// precision instruction (SQRTSS on amd64, FSQRT on arm64)
 	return float32(math.Sqrt(float64(x)))
 }
@@ -0,0 +1,73 @@
+//go:build exhaustive
+
+package math32
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// TestAccuracyExhaustive checks the documented bounds for every float32 input.
+// It takes a few minutes per function on a single core:
+//
+//	go test -tags exhaustive -run Exhaustive -timeout 0 -v
+func TestAccuracyExhaustive(t *testing.T) {
+	workers := runtime.GOMAXPROCS(0)
+
+	for _, a := range accuracies {
+		t.Run(a.name, func(t *testing.T) {
+			type result struct {
+				worst           float64
+				failures        uint32
+				worstAt, failAt float32
+			}
+			results := make([]result, workers)
+
+			var wg sync.WaitGroup
+			for w := 0; w < workers; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					r := &results[w]
+					for i := uint64(w); i < 1<<32; i += uint64(workers) {
+						x := math.Float32frombits(uint32(i))
+						if !a.inDomain(x) {
+							continue
+						}
+						e, ok := a.check(x)
+						if !ok {
+							if r.failures == 0 {
+								r.failAt = x
+							}
+							r.failures++
+						}
+						if e > r.worst {
+							r.worst, r.worstAt = e, x
+						}
+					}
+				}(w)
+			}
+			wg.Wait()
+
+			var total result
+			for _, r := range results {
+				if r.worst > total.worst {
+					total.worst, total.worstAt = r.worst, r.worstAt
+				}
+				if r.failures > 0 && total.failures == 0 {
+					total.failAt = r.failAt
+				}
+				total.failures += r.failures
+			}
+
+			t.Logf("%s: maximum error %s at x=%v", a.name, a.format(total.worst), total.worstAt)
+			if total.failures > 0 {
+				x := total.failAt
+				t.Errorf("%s exceeds its bound for %d inputs, including %s(%v) = %v, want %v",
+					a.name, total.failures, a.name, x, a.f(x), float32(a.ref(float64(x))))
+			}
+		})
+	}
+}
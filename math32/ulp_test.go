@@ -0,0 +1,292 @@
+package math32
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// ulpDiff returns the distance in units in the last place between a and b.
+// Two NaNs are considered equal.
+func ulpDiff(a, b float32) uint32 {
+	if IsNaN(a) && IsNaN(b) {
+		return 0
+	}
+	if IsNaN(a) || IsNaN(b) {
+		return math.MaxUint32
+	}
+
+	ia := int64(int32(math.Float32bits(a)))
+	ib := int64(int32(math.Float32bits(b)))
+	// Map the sign-magnitude encoding onto a monotonic integer line.
+	if ia < 0 {
+		ia = math.MinInt32 - ia
+	}
+	if ib < 0 {
+		ib = math.MinInt32 - ib
+	}
+	d := ia - ib
+	if d < 0 {
+		d = -d
+	}
+	return uint32(d)
+}
+
+// uniformInputs returns n random values in [lo, hi).
+func uniformInputs(n int, lo, hi float64) []float32 {
+	random := rand.New(rand.NewSource(1))
+	xs := make([]float32, n)
+	for i := range xs {
+		xs[i] = float32(lo + (hi-lo)*random.Float64())
+	}
+	return xs
+}
+
+// magnitudeInputs returns n random positive values whose decimal exponents
+// are spread uniformly over [minExp, maxExp).
+func magnitudeInputs(n int, minExp, maxExp float64) []float32 {
+	random := rand.New(rand.NewSource(1))
+	xs := make([]float32, n)
+	for i := range xs {
+		xs[i] = float32(math.Pow(10, minExp+(maxExp-minExp)*random.Float64()))
+	}
+	return xs
+}
+
+// checkULP fails the test if f differs from the float64 reference ref by more than
+// maxULP units in the last place for any of the inputs.
+func checkULP(t *testing.T, name string, f func(float32) float32, ref func(float64) float64, inputs []float32, maxULP uint32) {
+	t.Helper()
+
+	var worst uint32
+	var worstAt float32
+	for _, x := range inputs {
+		if d := ulpDiff(f(x), float32(ref(float64(x)))); d > worst {
+			worst = d
+			worstAt = x
+		}
+	}
+
+	t.Logf("%s: maximum error %d ULP at x=%v", name, worst, worstAt)
+
+	if worst > maxULP {
+		t.Errorf("%s(%v) = %v, want %v (error %d ULP, tolerance %d ULP)",
+			name, worstAt, f(worstAt), float32(ref(float64(worstAt))), worst, maxULP)
+	}
+}
+
+// identical reports whether a and b have the same bits, treating all NaNs as equal.
+// Unlike ==, it distinguishes +0 from -0.
+func identical(a, b float32) bool {
+	if IsNaN(a) || IsNaN(b) {
+		return IsNaN(a) && IsNaN(b)
+	}
+	return math.Float32bits(a) == math.Float32bits(b)
+}
+
+// accuracy is the documented error bound of a unary function.
+type accuracy struct {
+	name string
+	f    func(float32) float32
+	ref  func(float64) float64
+	// limit restricts the bound to inputs with |x| <= limit. Zero means all inputs.
+	limit float32
+	// maxULP is the largest accepted distance from the correctly rounded result.
+	maxULP uint32
+	// maxAbs, if nonzero, also accepts results within maxAbs of the exact value.
+	// Sin and Cos are only accurate in absolute terms once the argument is reduced.
+	maxAbs float64
+}
+
+// accuracies lists the bounds stated in the README.
+var accuracies = []accuracy{
+	{name: "Sin", f: Sin, ref: math.Sin, limit: Pi / 4, maxULP: 1},
+	{name: "Sin/absolute", f: Sin, ref: math.Sin, limit: 8192, maxULP: 1, maxAbs: 0x1p-22},
+	{name: "Cos", f: Cos, ref: math.Cos, limit: Pi / 4, maxULP: 1},
+	{name: "Cos/absolute", f: Cos, ref: math.Cos, limit: 8192, maxULP: 1, maxAbs: 0x1p-22},
+	{name: "Tan", f: Tan, ref: math.Tan, limit: Pi / 4, maxULP: 3},
+	{name: "Asin", f: Asin, ref: math.Asin, maxULP: 9},
+	{name: "Acos", f: Acos, ref: math.Acos, maxULP: 7},
+	{name: "Atan", f: Atan, ref: math.Atan, maxULP: 3},
+	{name: "Sinh", f: Sinh, ref: math.Sinh, maxULP: 6},
+	{name: "Cosh", f: Cosh, ref: math.Cosh, maxULP: 5},
+	{name: "Tanh", f: Tanh, ref: math.Tanh, maxULP: 5},
+	{name: "Asinh", f: Asinh, ref: math.Asinh, maxULP: 5},
+	{name: "Acosh", f: Acosh, ref: math.Acosh, maxULP: 5},
+	{name: "Atanh", f: Atanh, ref: math.Atanh, maxULP: 4},
+	{name: "Exp", f: Exp, ref: math.Exp, maxULP: 3},
+	{name: "Exp2", f: Exp2, ref: math.Exp2, maxULP: 3},
+	{name: "Expm1", f: Expm1, ref: math.Expm1, maxULP: 5},
+	{name: "Log", f: Log, ref: math.Log, maxULP: 3},
+	{name: "Log2", f: Log2, ref: math.Log2, maxULP: 4},
+	{name: "Log10", f: Log10, ref: math.Log10, maxULP: 4},
+	{name: "Log1p", f: Log1p, ref: math.Log1p, maxULP: 4},
+	{name: "Sqrt", f: Sqrt, ref: math.Sqrt, maxULP: 0},
+	{name: "Cbrt", f: Cbrt, ref: math.Cbrt, maxULP: 1},
+	{name: "Floor", f: Floor, ref: math.Floor, maxULP: 0},
+	{name: "Ceil", f: Ceil, ref: math.Ceil, maxULP: 0},
+	{name: "Round", f: Round, ref: math.Round, maxULP: 0},
+	{name: "Trunc", f: Trunc, ref: math.Trunc, maxULP: 0},
+}
+
+// inDomain reports whether x is covered by the bound.
+func (a accuracy) inDomain(x float32) bool {
+	return a.limit == 0 || Abs(x) <= a.limit
+}
+
+// check returns the error of f at x, in ULPs or in absolute terms if maxAbs is set,
+// and whether it is within the bound.
+func (a accuracy) check(x float32) (float64, bool) {
+	got := a.f(x)
+	want := a.ref(float64(x))
+	d := ulpDiff(got, float32(want))
+	if a.maxAbs == 0 {
+		return float64(d), d <= a.maxULP
+	}
+	e := math.Abs(float64(got) - want)
+	if d <= a.maxULP || IsNaN(got) && math.IsNaN(want) {
+		e = 0
+	}
+	return e, e <= a.maxAbs
+}
+
+// format formats an error returned by check.
+func (a accuracy) format(e float64) string {
+	if a.maxAbs == 0 {
+		return fmt.Sprintf("%d ULP", uint32(e))
+	}
+	return fmt.Sprintf("%.3g", e)
+}
+
+// sampledInputs returns n random bit patterns, which cover every binade and all the
+// special values, followed by n values spread uniformly over [-hi, hi].
+func sampledInputs(n int, hi float64) []float32 {
+	random := rand.New(rand.NewSource(1))
+	xs := make([]float32, 0, 2*n)
+	for i := 0; i < n; i++ {
+		xs = append(xs, math.Float32frombits(random.Uint32()))
+	}
+	for i := 0; i < n; i++ {
+		xs = append(xs, float32(hi*(2*random.Float64()-1)))
+	}
+	return xs
+}
+
+func TestAccuracySampled(t *testing.T) {
+	n := 1 << 20
+	if testing.Short() {
+		n = 1 << 14
+	}
+
+	for _, a := range accuracies {
+		t.Run(a.name, func(t *testing.T) {
+			hi := float64(a.limit)
+			if hi == 0 {
+				hi = 100
+			}
+
+			var worst float64
+			var worstAt float32
+			for _, x := range sampledInputs(n, hi) {
+				if !a.inDomain(x) {
+					continue
+				}
+				e, ok := a.check(x)
+				if !ok {
+					t.Fatalf("%s(%v) = %v, want %v (error %s)",
+						a.name, x, a.f(x), float32(a.ref(float64(x))), a.format(e))
+				}
+				if e > worst {
+					worst, worstAt = e, x
+				}
+			}
+
+			t.Logf("%s: maximum error %s at x=%v", a.name, a.format(worst), worstAt)
+		})
+	}
+}
+
+func TestAccuracyBinary(t *testing.T) {
+	n := 1 << 20
+	if testing.Short() {
+		n = 1 << 14
+	}
+
+	random := rand.New(rand.NewSource(1))
+	// magnitude returns a random value whose binary exponent is uniform in [-e, e).
+	magnitude := func(e float64) float32 {
+		return float32(math.Exp2(e * (2*random.Float64() - 1)))
+	}
+	sign := func() float32 {
+		return float32(2*random.Intn(2) - 1)
+	}
+
+	tests := []struct {
+		name string
+		f    func(x, y float32) float32
+		ref  func(x, y float64) float64
+		// maxULP returns the bound for the given arguments.
+		maxULP func(x, y float64) float64
+		// input returns random arguments.
+		input func() (float32, float32)
+	}{
+		{
+			name:   "Atan2",
+			f:      Atan2,
+			ref:    math.Atan2,
+			maxULP: func(x, y float64) float64 { return 3 },
+			input:  func() (float32, float32) { return sign() * magnitude(126), sign() * magnitude(126) },
+		},
+		{
+			name:   "Hypot",
+			f:      Hypot,
+			ref:    math.Hypot,
+			maxULP: func(x, y float64) float64 { return 2 },
+			input:  func() (float32, float32) { return sign() * magnitude(126), sign() * magnitude(126) },
+		},
+		{
+			// The error of y·Log(x) is amplified by Exp, so the bound grows with
+			// the magnitude of the exponent.
+			name:   "Pow",
+			f:      Pow,
+			ref:    math.Pow,
+			maxULP: func(x, y float64) float64 { return 3 + 5*math.Abs(y*math.Log(x)) },
+			input: func() (float32, float32) {
+				switch random.Intn(3) {
+				case 0:
+					return magnitude(126), 8 * sign() * random.Float32()
+				case 1:
+					// Bases close to one with large exponents.
+					return 0.5 + random.Float32(), 128 * sign() * random.Float32()
+				}
+				return magnitude(126), 128 * sign() * random.Float32()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var worst uint32
+			var worstX, worstY float32
+			for i := 0; i < n; i++ {
+				x, y := tt.input()
+				want := tt.ref(float64(x), float64(y))
+				if math.Abs(want) > MaxFloat32 || math.Abs(want) < 0x1p-126 {
+					// Overflow and gradual underflow lose the relative accuracy.
+					continue
+				}
+				got := tt.f(x, y)
+				d := ulpDiff(got, float32(want))
+				if float64(d) > tt.maxULP(float64(x), float64(y)) {
+					t.Fatalf("%s(%v, %v) = %v, want %v (error %d ULP)", tt.name, x, y, got, float32(want), d)
+				}
+				if d > worst {
+					worst, worstX, worstY = d, x, y
+				}
+			}
+
+			t.Logf("%s: maximum error %d ULP at (%v, %v)", tt.name, worst, worstX, worstY)
+		})
+	}
+}
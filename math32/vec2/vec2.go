@@ -0,0 +1,130 @@
+// Package vec2 provides utility functions to work with 2D vectors such as texture coordinates.
+package vec2
+
+import "github.com/flynn-nrg/go-vfx/math32"
+
+// Vec2Impl defines a vector with two components.
+type Vec2Impl struct {
+	X float32
+	Y float32
+}
+
+// Length returns the length of this vector.
+func (v Vec2Impl) Length() float32 {
+	return math32.Sqrt((v.X * v.X) + (v.Y * v.Y))
+}
+
+// SquaredLength returns the squared length of this vector.
+func (v Vec2Impl) SquaredLength() float32 {
+	return (v.X * v.X) + (v.Y * v.Y)
+}
+
+// MakeUnitVector transform the vector into its unit representation.
+func (v Vec2Impl) MakeUnitVector() Vec2Impl {
+	l := v.Length()
+	v.X = v.X / l
+	v.Y = v.Y / l
+
+	return v
+}
+
+// Add returns the sum of two or more vectors.
+func Add(v1 Vec2Impl, args ...Vec2Impl) Vec2Impl {
+	sum := v1
+
+	for i := range args {
+		sum.X += args[i].X
+		sum.Y += args[i].Y
+	}
+
+	return sum
+}
+
+// Sub returns the subtraction of two or more vectors.
+func Sub(v1 Vec2Impl, args ...Vec2Impl) Vec2Impl {
+	res := v1
+
+	for i := range args {
+		res.X -= args[i].X
+		res.Y -= args[i].Y
+	}
+
+	return res
+}
+
+// Mul returns the multiplication of two vectors.
+func Mul(v1 Vec2Impl, v2 Vec2Impl) Vec2Impl {
+	return Vec2Impl{
+		X: v1.X * v2.X,
+		Y: v1.Y * v2.Y,
+	}
+}
+
+// Div returns the division of two vectors.
+func Div(v1 Vec2Impl, v2 Vec2Impl) Vec2Impl {
+	return Vec2Impl{
+		X: v1.X / v2.X,
+		Y: v1.Y / v2.Y,
+	}
+}
+
+// ScalarMul returns the scalar multiplication of the given vector and scalar values.
+func ScalarMul(v1 Vec2Impl, t float32) Vec2Impl {
+	return Vec2Impl{
+		X: v1.X * t,
+		Y: v1.Y * t,
+	}
+}
+
+// ScalarDiv returns the scalar division of the given vector and scalar values.
+func ScalarDiv(v1 Vec2Impl, t float32) Vec2Impl {
+	return Vec2Impl{
+		X: v1.X / t,
+		Y: v1.Y / t,
+	}
+}
+
+// Dot computes the dot product of the two supplied vectors.
+func Dot(v1 Vec2Impl, v2 Vec2Impl) float32 {
+	return (v1.X * v2.X) + (v1.Y * v2.Y)
+}
+
+// Cross returns the Z component of the cross product of the two supplied vectors
+// when they are extended to 3D with Z = 0.
+func Cross(v1 Vec2Impl, v2 Vec2Impl) float32 {
+	return (v1.X * v2.Y) - (v1.Y * v2.X)
+}
+
+// UnitVector returns a unit vector representation of the supplied vector.
+func UnitVector(v Vec2Impl) Vec2Impl {
+	return ScalarDiv(v, v.Length())
+}
+
+// DeNAN ensures that the vector elements are numbers.
+func DeNAN(v Vec2Impl) Vec2Impl {
+	x := v.X
+	y := v.Y
+	if math32.IsNaN(x) || math32.IsInf(x, -1) || math32.IsInf(x, 1) {
+		x = 0
+	}
+
+	if math32.IsNaN(y) || math32.IsInf(y, -1) || math32.IsInf(y, 1) {
+		y = 0
+	}
+
+	return Vec2Impl{X: x, Y: y}
+}
+
+// Lerp performs a linear interpolation between the two provided vectors.
+func Lerp(v0, v1 Vec2Impl, t float32) Vec2Impl {
+	return Vec2Impl{
+		X: (1-t)*v0.X + t*v1.X,
+		Y: (1-t)*v0.Y + t*v1.Y,
+	}
+}
+
+// Equals returns whether two vectors are the same.
+func Equals(v0, v1 Vec2Impl) bool {
+	return v0.X == v1.X &&
+		v0.Y == v1.Y
+}
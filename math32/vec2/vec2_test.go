@@ -0,0 +1,63 @@
+package vec2
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOperations(t *testing.T) {
+	a := Vec2Impl{X: 3, Y: 4}
+	b := Vec2Impl{X: 1, Y: -2}
+
+	testData := []struct {
+		name string
+		got  Vec2Impl
+		want Vec2Impl
+	}{
+		{name: "Add", got: Add(a, b, b), want: Vec2Impl{X: 5, Y: 0}},
+		{name: "Sub", got: Sub(a, b, b), want: Vec2Impl{X: 1, Y: 8}},
+		{name: "Mul", got: Mul(a, b), want: Vec2Impl{X: 3, Y: -8}},
+		{name: "Div", got: Div(a, b), want: Vec2Impl{X: 3, Y: -2}},
+		{name: "ScalarMul", got: ScalarMul(a, 2), want: Vec2Impl{X: 6, Y: 8}},
+		{name: "ScalarDiv", got: ScalarDiv(a, 2), want: Vec2Impl{X: 1.5, Y: 2}},
+		{name: "UnitVector", got: UnitVector(a), want: Vec2Impl{X: 0.6, Y: 0.8}},
+		{name: "MakeUnitVector", got: a.MakeUnitVector(), want: Vec2Impl{X: 0.6, Y: 0.8}},
+		{name: "Lerp", got: Lerp(a, b, 0.5), want: Vec2Impl{X: 2, Y: 1}},
+		{name: "DeNAN", got: DeNAN(Vec2Impl{X: math32.NaN(), Y: math32.Inf(-1)}), want: Vec2Impl{}},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.got); diff != "" {
+				t.Errorf("%s() mismatch (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+}
+
+func TestScalars(t *testing.T) {
+	a := Vec2Impl{X: 3, Y: 4}
+	b := Vec2Impl{X: 1, Y: -2}
+
+	if got := a.Length(); got != 5 {
+		t.Errorf("Length() = %v, want 5", got)
+	}
+
+	if got := a.SquaredLength(); got != 25 {
+		t.Errorf("SquaredLength() = %v, want 25", got)
+	}
+
+	if got := Dot(a, b); got != -5 {
+		t.Errorf("Dot() = %v, want -5", got)
+	}
+
+	if got := Cross(a, b); got != -10 {
+		t.Errorf("Cross() = %v, want -10", got)
+	}
+
+	if !Equals(a, Vec2Impl{X: 3, Y: 4}) || Equals(a, b) {
+		t.Errorf("Equals() returned the wrong result")
+	}
+}
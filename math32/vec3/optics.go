@@ -0,0 +1,101 @@
+package vec3
+
+import "github.com/flynn-nrg/go-vfx/math32"
+
+// Reflect returns the reflection of the incident direction v around the normal n.
+func Reflect(v Vec3Impl, n Vec3Impl) Vec3Impl {
+	// r = v - 2*dot(v,n)*n
+	dot := Dot(v, n)
+	return Vec3Impl{
+		X: v.X - 2*dot*n.X,
+		Y: v.Y - 2*dot*n.Y,
+		Z: v.Z - 2*dot*n.Z,
+	}
+}
+
+// Refract returns the refraction of the incident direction v through a surface with unit normal n,
+// where niOverNt is the ratio of the refractive indices on the incident and transmitted sides.
+// The normal must point against v. The boolean result is false on total internal reflection.
+func Refract(v Vec3Impl, n Vec3Impl, niOverNt float32) (Vec3Impl, bool) {
+	uv := UnitVector(v)
+	dt := Dot(uv, n)
+	discriminant := 1 - niOverNt*niOverNt*(1-dt*dt)
+	if discriminant <= 0 {
+		return Vec3Impl{}, false
+	}
+
+	// refracted = niOverNt * (uv - n*dt) - n*sqrt(discriminant)
+	s := math32.Sqrt(discriminant)
+	return Vec3Impl{
+		X: niOverNt*(uv.X-n.X*dt) - n.X*s,
+		Y: niOverNt*(uv.Y-n.Y*dt) - n.Y*s,
+		Z: niOverNt*(uv.Z-n.Z*dt) - n.Z*s,
+	}, true
+}
+
+// FaceForward returns n flipped if needed so that it lies in the same hemisphere as v.
+func FaceForward(n Vec3Impl, v Vec3Impl) Vec3Impl {
+	if Dot(n, v) < 0 {
+		return Vec3Impl{X: -n.X, Y: -n.Y, Z: -n.Z}
+	}
+
+	return n
+}
+
+// Schlick computes Schlick's approximation to the Fresnel reflectance of a dielectric
+// with relative refractive index refIdx, given the cosine of the incident angle.
+func Schlick(cosine float32, refIdx float32) float32 {
+	r0 := (1 - refIdx) / (1 + refIdx)
+	r0 = r0 * r0
+	m := 1 - cosine
+	return r0 + (1-r0)*m*m*m*m*m
+}
+
+// FresnelDielectric computes the exact unpolarised Fresnel reflectance at the boundary between two dielectrics.
+// cosThetaI is the cosine of the incident angle with respect to the normal and eta is the ratio of the
+// refractive index on the transmitted side to the one on the incident side. A negative cosThetaI means
+// that the light arrives from the transmitted side, in which case eta is inverted.
+// Total internal reflection returns 1.
+func FresnelDielectric(cosThetaI float32, eta float32) float32 {
+	cosThetaI = math32.Min(math32.Max(cosThetaI, -1), 1)
+	if cosThetaI < 0 {
+		eta = 1 / eta
+		cosThetaI = -cosThetaI
+	}
+
+	sin2ThetaI := 1 - cosThetaI*cosThetaI
+	sin2ThetaT := sin2ThetaI / (eta * eta)
+	if sin2ThetaT >= 1 {
+		return 1
+	}
+
+	cosThetaT := math32.Sqrt(1 - sin2ThetaT)
+	rParallel := (eta*cosThetaI - cosThetaT) / (eta*cosThetaI + cosThetaT)
+	rPerpendicular := (cosThetaI - eta*cosThetaT) / (cosThetaI + eta*cosThetaT)
+
+	return (rParallel*rParallel + rPerpendicular*rPerpendicular) / 2
+}
+
+// FresnelConductor computes the unpolarised Fresnel reflectance of a conductor with complex
+// refractive index eta + i*k relative to the incident medium, given the cosine of the incident angle.
+// Apply it per colour channel for spectrally varying conductors.
+func FresnelConductor(cosThetaI float32, eta float32, k float32) float32 {
+	cosThetaI = math32.Min(math32.Max(cosThetaI, 0), 1)
+	cos2ThetaI := cosThetaI * cosThetaI
+	sin2ThetaI := 1 - cos2ThetaI
+	eta2 := eta * eta
+	k2 := k * k
+
+	t0 := eta2 - k2 - sin2ThetaI
+	a2plusb2 := math32.Sqrt(t0*t0 + 4*eta2*k2)
+	t1 := a2plusb2 + cos2ThetaI
+	a := math32.Sqrt(0.5 * (a2plusb2 + t0))
+	t2 := 2 * cosThetaI * a
+	rs := (t1 - t2) / (t1 + t2)
+
+	t3 := cos2ThetaI*a2plusb2 + sin2ThetaI*sin2ThetaI
+	t4 := t2 * sin2ThetaI
+	rp := rs * (t3 - t4) / (t3 + t4)
+
+	return (rp + rs) / 2
+}
@@ -0,0 +1,171 @@
+package vec3
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// fresnelDielectric64 is the float64 reference using the textbook Fresnel equations.
+func fresnelDielectric64(cosThetaI, eta float64) float64 {
+	if cosThetaI < 0 {
+		eta = 1 / eta
+		cosThetaI = -cosThetaI
+	}
+	sinThetaT := math.Sqrt(1-cosThetaI*cosThetaI) / eta
+	if sinThetaT >= 1 {
+		return 1
+	}
+	cosThetaT := math.Sqrt(1 - sinThetaT*sinThetaT)
+	rs := (cosThetaI - eta*cosThetaT) / (cosThetaI + eta*cosThetaT)
+	rp := (eta*cosThetaI - cosThetaT) / (eta*cosThetaI + cosThetaT)
+	return (rs*rs + rp*rp) / 2
+}
+
+// fresnelConductor64 is the float64 reference using complex arithmetic.
+func fresnelConductor64(cosThetaI, eta, k float64) float64 {
+	n := complex(eta, k)
+	sin2ThetaI := complex(1-cosThetaI*cosThetaI, 0)
+	cosI := complex(cosThetaI, 0)
+	cosT := cmplx.Sqrt(1 - sin2ThetaI/(n*n))
+	rs := (cosI - n*cosT) / (cosI + n*cosT)
+	rp := (n*cosI - cosT) / (n*cosI + cosT)
+	return (real(rs*cmplx.Conj(rs)) + real(rp*cmplx.Conj(rp))) / 2
+}
+
+func TestReflect(t *testing.T) {
+	approx := cmpopts.EquateApprox(0, 1e-6)
+
+	testData := []struct {
+		name   string
+		v      Vec3Impl
+		normal Vec3Impl
+		want   Vec3Impl
+	}{
+		{
+			name:   "Head on",
+			v:      Vec3Impl{Z: -1},
+			normal: Vec3Impl{Z: 1},
+			want:   Vec3Impl{Z: 1},
+		},
+		{
+			name:   "45 degrees",
+			v:      Vec3Impl{X: 1, Y: -1},
+			normal: Vec3Impl{Y: 1},
+			want:   Vec3Impl{X: 1, Y: 1},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, Reflect(test.v, test.normal), approx); diff != "" {
+				t.Errorf("Reflect() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRefract(t *testing.T) {
+	normal := Vec3Impl{Y: 1}
+
+	for _, eta := range []float64{1 / 1.5, 1, 1.5} {
+		for _, thetaI := range []float64{0, 0.2, 0.5, 0.7, 1.0, 1.3, 1.5} {
+			v := Vec3Impl{X: float32(math.Sin(thetaI)), Y: float32(-math.Cos(thetaI))}
+			got, ok := Refract(v, normal, float32(eta))
+
+			// Snell's law: sin(thetaT) = eta * sin(thetaI)
+			sinThetaT := eta * math.Sin(thetaI)
+			if sinThetaT >= 1 {
+				if ok {
+					t.Errorf("Refract(%v, eta=%v) = %+v, want total internal reflection", thetaI, eta, got)
+				}
+				continue
+			}
+
+			if !ok {
+				t.Errorf("Refract(%v, eta=%v) reported total internal reflection", thetaI, eta)
+				continue
+			}
+
+			want := Vec3Impl{X: float32(sinThetaT), Y: float32(-math.Sqrt(1 - sinThetaT*sinThetaT))}
+			if diff := cmp.Diff(want, got, cmpopts.EquateApprox(0, 1e-6)); diff != "" {
+				t.Errorf("Refract(%v, eta=%v) mismatch (-want +got):\n%s", thetaI, eta, diff)
+			}
+		}
+	}
+}
+
+func TestFaceForward(t *testing.T) {
+	n := Vec3Impl{Z: 1}
+
+	if diff := cmp.Diff(n, FaceForward(n, Vec3Impl{X: 1, Z: 0.1})); diff != "" {
+		t.Errorf("FaceForward() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(Vec3Impl{Z: -1}, FaceForward(n, Vec3Impl{X: 1, Z: -0.1})); diff != "" {
+		t.Errorf("FaceForward() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFresnelDielectric(t *testing.T) {
+	for _, eta := range []float64{1, 1.33, 1.5, 2.4} {
+		for i := -100; i <= 100; i++ {
+			cosThetaI := float64(i) / 100
+			got := float64(FresnelDielectric(float32(cosThetaI), float32(eta)))
+			want := fresnelDielectric64(cosThetaI, eta)
+			if diff := math.Abs(got - want); diff > 2e-6 {
+				t.Errorf("FresnelDielectric(%v, %v) = %v, want %v (diff: %e)", cosThetaI, eta, got, want, diff)
+			}
+		}
+	}
+}
+
+func TestFresnelConductor(t *testing.T) {
+	// Approximate RGB complex IORs of common metals.
+	metals := []struct {
+		name   string
+		eta, k float64
+	}{
+		{"Gold red", 0.143, 3.983},
+		{"Copper green", 0.948, 2.577},
+		{"Aluminium blue", 1.657, 9.224},
+		{"Dielectric limit", 1.5, 0},
+	}
+
+	for _, metal := range metals {
+		for i := 0; i <= 100; i++ {
+			cosThetaI := float64(i) / 100
+			got := float64(FresnelConductor(float32(cosThetaI), float32(metal.eta), float32(metal.k)))
+			want := fresnelConductor64(cosThetaI, metal.eta, metal.k)
+			if diff := math.Abs(got - want); diff > 1e-5 {
+				t.Errorf("%s: FresnelConductor(%v) = %v, want %v (diff: %e)", metal.name, cosThetaI, got, want, diff)
+			}
+		}
+	}
+}
+
+func TestSchlick(t *testing.T) {
+	for _, refIdx := range []float64{1.33, 1.5, 2.4} {
+		// Schlick is exact at normal incidence and grazing angles.
+		r0 := math.Pow((1-refIdx)/(1+refIdx), 2)
+		if got := float64(Schlick(1, float32(refIdx))); math.Abs(got-r0) > 1e-7 {
+			t.Errorf("Schlick(1, %v) = %v, want %v", refIdx, got, r0)
+		}
+		if got := float64(Schlick(0, float32(refIdx))); math.Abs(got-1) > 1e-7 {
+			t.Errorf("Schlick(0, %v) = %v, want 1", refIdx, got)
+		}
+
+		// And stays close to the exact dielectric Fresnel term elsewhere.
+		for i := 0; i <= 100; i++ {
+			cosThetaI := float64(i) / 100
+			got := float64(Schlick(float32(cosThetaI), float32(refIdx)))
+			want := fresnelDielectric64(cosThetaI, refIdx)
+			if diff := math.Abs(got - want); diff > 0.1 {
+				t.Errorf("Schlick(%v, %v) = %v, exact %v (diff: %e)", cosThetaI, refIdx, got, want, diff)
+			}
+		}
+	}
+}
@@ -33,6 +33,75 @@ func (v Vec3Impl) MakeUnitVector() Vec3Impl {
 	return v
 }
 
+// Add returns the sum of this vector and w.
+// Unlike the variadic Add function it does not build a slice and is always inlined.
+func (v Vec3Impl) Add(w Vec3Impl) Vec3Impl {
+	return Vec3Impl{X: v.X + w.X, Y: v.Y + w.Y, Z: v.Z + w.Z}
+}
+
+// Sub returns the result of subtracting w from this vector.
+// Unlike the variadic Sub function it does not build a slice and is always inlined.
+func (v Vec3Impl) Sub(w Vec3Impl) Vec3Impl {
+	return Vec3Impl{X: v.X - w.X, Y: v.Y - w.Y, Z: v.Z - w.Z}
+}
+
+// Mul returns the component-wise multiplication of this vector and w.
+func (v Vec3Impl) Mul(w Vec3Impl) Vec3Impl {
+	return Vec3Impl{X: v.X * w.X, Y: v.Y * w.Y, Z: v.Z * w.Z}
+}
+
+// Scale returns this vector multiplied by the scalar t.
+func (v Vec3Impl) Scale(t float32) Vec3Impl {
+	return Vec3Impl{X: v.X * t, Y: v.Y * t, Z: v.Z * t}
+}
+
+// Neg returns this vector with all its components negated.
+func (v Vec3Impl) Neg() Vec3Impl {
+	return Vec3Impl{X: -v.X, Y: -v.Y, Z: -v.Z}
+}
+
+// Dot computes the dot product of this vector and w.
+func (v Vec3Impl) Dot(w Vec3Impl) float32 {
+	return (v.X * w.X) + (v.Y * w.Y) + (v.Z * w.Z)
+}
+
+// Cross computes the cross product of this vector and w.
+func (v Vec3Impl) Cross(w Vec3Impl) Vec3Impl {
+	return Vec3Impl{
+		X: (v.Y * w.Z) - (v.Z * w.Y),
+		Y: -((v.X * w.Z) - (v.Z * w.X)),
+		Z: (v.X * w.Y) - (v.Y * w.X),
+	}
+}
+
+// AddAssign adds w to this vector in place.
+func (v *Vec3Impl) AddAssign(w Vec3Impl) {
+	v.X += w.X
+	v.Y += w.Y
+	v.Z += w.Z
+}
+
+// SubAssign subtracts w from this vector in place.
+func (v *Vec3Impl) SubAssign(w Vec3Impl) {
+	v.X -= w.X
+	v.Y -= w.Y
+	v.Z -= w.Z
+}
+
+// MulAssign multiplies this vector component-wise by w in place.
+func (v *Vec3Impl) MulAssign(w Vec3Impl) {
+	v.X *= w.X
+	v.Y *= w.Y
+	v.Z *= w.Z
+}
+
+// ScaleAssign multiplies this vector by the scalar t in place.
+func (v *Vec3Impl) ScaleAssign(t float32) {
+	v.X *= t
+	v.Y *= t
+	v.Z *= t
+}
+
 // Add returns the sum of two or more vectors.
 func Add(v1 Vec3Impl, args ...Vec3Impl) Vec3Impl {
 	sum := v1
@@ -114,14 +183,39 @@ func UnitVector(v Vec3Impl) Vec3Impl {
 	return ScalarDiv(v, v.Length())
 }
 
+// OrthonormalBasis returns a tangent and bitangent that together with the supplied unit normal
+// form a right-handed orthonormal basis. It uses the branchless construction from Duff et al.,
+// "Building an Orthonormal Basis, Revisited", JCGT 2017, which is continuous everywhere but
+// across the plane Z = 0 and stays accurate near the poles.
+func OrthonormalBasis(n Vec3Impl) (Vec3Impl, Vec3Impl) {
+	sign := math32.Copysign(1, n.Z)
+	a := -1 / (sign + n.Z)
+	b := n.X * n.Y * a
+
+	tangent := Vec3Impl{
+		X: 1 + sign*n.X*n.X*a,
+		Y: sign * b,
+		Z: -sign * n.X,
+	}
+
+	bitangent := Vec3Impl{
+		X: b,
+		Y: sign + n.Y*n.Y*a,
+		Z: -n.Y,
+	}
+
+	return tangent, bitangent
+}
+
 // RandomCosineDirection returns a vector with a random cosine direction.
 func RandomCosineDirection(random *fastrandom.XorShift) Vec3Impl {
 	r1 := random.Float32()
 	r2 := random.Float32()
 	z := math32.Sqrt(1 - r2)
 	phi := 2 * math32.Pi * r1
-	x := math32.Cos(phi) * 2 * math32.Sqrt(r2)
-	y := math32.Sin(phi) * 2 * math32.Sqrt(r2)
+	sinPhi, cosPhi := math32.Sincos(phi)
+	x := cosPhi * 2 * math32.Sqrt(r2)
+	y := sinPhi * 2 * math32.Sqrt(r2)
 	return Vec3Impl{X: x, Y: y, Z: z}
 }
 
@@ -131,8 +225,9 @@ func RandomToSphere(radius float32, distanceSquared float32, random *fastrandom.
 	r2 := random.Float32()
 	z := 1 + r2*(math32.Sqrt(1-radius*radius/distanceSquared)-1)
 	phi := 2 * math32.Pi * r1
-	x := math32.Cos(phi) * math32.Sqrt(1-z*z)
-	y := math32.Sin(phi) * math32.Sqrt(1-z*z)
+	sinPhi, cosPhi := math32.Sincos(phi)
+	x := cosPhi * math32.Sqrt(1-z*z)
+	y := sinPhi * math32.Sqrt(1-z*z)
 	return Vec3Impl{X: x, Y: y, Z: z}
 }
 
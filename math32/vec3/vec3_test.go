@@ -0,0 +1,136 @@
+package vec3
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMethodsMatchFunctions(t *testing.T) {
+	a := Vec3Impl{X: 1.5, Y: -2, Z: 3}
+	b := Vec3Impl{X: -4, Y: 0.25, Z: 6}
+
+	testData := []struct {
+		name string
+		got  Vec3Impl
+		want Vec3Impl
+	}{
+		{name: "Add", got: a.Add(b), want: Add(a, b)},
+		{name: "Sub", got: a.Sub(b), want: Sub(a, b)},
+		{name: "Mul", got: a.Mul(b), want: Mul(a, b)},
+		{name: "Scale", got: a.Scale(-3), want: ScalarMul(a, -3)},
+		{name: "Neg", got: a.Neg(), want: ScalarMul(a, -1)},
+		{name: "Cross", got: a.Cross(b), want: Cross(a, b)},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.got); diff != "" {
+				t.Errorf("%s() mismatch (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+
+	if got, want := a.Dot(b), Dot(a, b); got != want {
+		t.Errorf("Dot() = %v, want %v", got, want)
+	}
+}
+
+func TestAssignMethods(t *testing.T) {
+	a := Vec3Impl{X: 1.5, Y: -2, Z: 3}
+	b := Vec3Impl{X: -4, Y: 0.25, Z: 6}
+
+	testData := []struct {
+		name   string
+		assign func(v *Vec3Impl)
+		want   Vec3Impl
+	}{
+		{name: "AddAssign", assign: func(v *Vec3Impl) { v.AddAssign(b) }, want: Add(a, b)},
+		{name: "SubAssign", assign: func(v *Vec3Impl) { v.SubAssign(b) }, want: Sub(a, b)},
+		{name: "MulAssign", assign: func(v *Vec3Impl) { v.MulAssign(b) }, want: Mul(a, b)},
+		{name: "ScaleAssign", assign: func(v *Vec3Impl) { v.ScaleAssign(0.5) }, want: ScalarMul(a, 0.5)},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			got := a
+			test.assign(&got)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("%s() mismatch (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+}
+
+func BenchmarkAddVariadic(b *testing.B) {
+	v1 := Vec3Impl{X: 1, Y: 2, Z: 3}
+	v2 := Vec3Impl{X: 4, Y: 5, Z: 6}
+	var result Vec3Impl
+	for i := 0; i < b.N; i++ {
+		result = Add(result, v1, v2)
+	}
+	_ = result
+}
+
+func BenchmarkAddMethod(b *testing.B) {
+	v1 := Vec3Impl{X: 1, Y: 2, Z: 3}
+	v2 := Vec3Impl{X: 4, Y: 5, Z: 6}
+	var result Vec3Impl
+	for i := 0; i < b.N; i++ {
+		result = result.Add(v1).Add(v2)
+	}
+	_ = result
+}
+
+func BenchmarkAddAssign(b *testing.B) {
+	v1 := Vec3Impl{X: 1, Y: 2, Z: 3}
+	v2 := Vec3Impl{X: 4, Y: 5, Z: 6}
+	var result Vec3Impl
+	for i := 0; i < b.N; i++ {
+		result.AddAssign(v1)
+		result.AddAssign(v2)
+	}
+	_ = result
+}
+
+func TestOrthonormalBasis(t *testing.T) {
+	normals := []Vec3Impl{
+		{Z: 1},
+		{Z: -1},
+		{X: 1},
+		{Y: -1},
+		{X: 1e-4, Z: 1},
+		{X: 1e-4, Z: -1},
+		{X: 3e-4, Y: -2e-4, Z: -1},
+		{X: 1, Z: math32.Copysign(0, -1)},
+	}
+
+	// Fibonacci sphere to cover all directions uniformly.
+	const n = 10000
+	for i := 0; i < n; i++ {
+		z := 1 - (2*float32(i)+1)/n
+		r := math32.Sqrt(1 - z*z)
+		phi := float32(i) * 2 * math32.Pi / math32.Phi
+		normals = append(normals, Vec3Impl{X: r * math32.Cos(phi), Y: r * math32.Sin(phi), Z: z})
+	}
+
+	const tolerance = 1e-5
+	for _, normal := range normals {
+		normal = UnitVector(normal)
+		tangent, bitangent := OrthonormalBasis(normal)
+
+		for name, value := range map[string]float32{
+			"|t|":   tangent.Length() - 1,
+			"|b|":   bitangent.Length() - 1,
+			"t.b":   Dot(tangent, bitangent),
+			"t.n":   Dot(tangent, normal),
+			"b.n":   Dot(bitangent, normal),
+			"txb-n": Sub(Cross(tangent, bitangent), normal).Length(),
+		} {
+			if math32.Abs(value) > tolerance || math32.IsNaN(value) {
+				t.Errorf("OrthonormalBasis(%+v): %s = %e", normal, name, value)
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Package vec4 provides utility functions to work with 4D vectors such as RGBA colours and homogeneous coordinates.
+package vec4
+
+import (
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+)
+
+// Vec4Impl defines a vector with four components.
+type Vec4Impl struct {
+	X float32
+	Y float32
+	Z float32
+	W float32
+}
+
+// FromVec3 returns a new vector with the components of v and the supplied W component.
+// Use W = 1 for points and W = 0 for directions.
+func FromVec3(v vec3.Vec3Impl, w float32) Vec4Impl {
+	return Vec4Impl{X: v.X, Y: v.Y, Z: v.Z, W: w}
+}
+
+// Vec3 returns the X, Y and Z components of this vector.
+func (v Vec4Impl) Vec3() vec3.Vec3Impl {
+	return vec3.Vec3Impl{X: v.X, Y: v.Y, Z: v.Z}
+}
+
+// Length returns the length of this vector.
+func (v Vec4Impl) Length() float32 {
+	return math32.Sqrt((v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z) + (v.W * v.W))
+}
+
+// SquaredLength returns the squared length of this vector.
+func (v Vec4Impl) SquaredLength() float32 {
+	return (v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z) + (v.W * v.W)
+}
+
+// MakeUnitVector transform the vector into its unit representation.
+func (v Vec4Impl) MakeUnitVector() Vec4Impl {
+	l := v.Length()
+	v.X = v.X / l
+	v.Y = v.Y / l
+	v.Z = v.Z / l
+	v.W = v.W / l
+
+	return v
+}
+
+// Add returns the sum of two or more vectors.
+func Add(v1 Vec4Impl, args ...Vec4Impl) Vec4Impl {
+	sum := v1
+
+	for i := range args {
+		sum.X += args[i].X
+		sum.Y += args[i].Y
+		sum.Z += args[i].Z
+		sum.W += args[i].W
+	}
+
+	return sum
+}
+
+// Sub returns the subtraction of two or more vectors.
+func Sub(v1 Vec4Impl, args ...Vec4Impl) Vec4Impl {
+	res := v1
+
+	for i := range args {
+		res.X -= args[i].X
+		res.Y -= args[i].Y
+		res.Z -= args[i].Z
+		res.W -= args[i].W
+	}
+
+	return res
+}
+
+// Mul returns the multiplication of two vectors.
+func Mul(v1 Vec4Impl, v2 Vec4Impl) Vec4Impl {
+	return Vec4Impl{
+		X: v1.X * v2.X,
+		Y: v1.Y * v2.Y,
+		Z: v1.Z * v2.Z,
+		W: v1.W * v2.W,
+	}
+}
+
+// Div returns the division of two vectors.
+func Div(v1 Vec4Impl, v2 Vec4Impl) Vec4Impl {
+	return Vec4Impl{
+		X: v1.X / v2.X,
+		Y: v1.Y / v2.Y,
+		Z: v1.Z / v2.Z,
+		W: v1.W / v2.W,
+	}
+}
+
+// ScalarMul returns the scalar multiplication of the given vector and scalar values.
+func ScalarMul(v1 Vec4Impl, t float32) Vec4Impl {
+	return Vec4Impl{
+		X: v1.X * t,
+		Y: v1.Y * t,
+		Z: v1.Z * t,
+		W: v1.W * t,
+	}
+}
+
+// ScalarDiv returns the scalar division of the given vector and scalar values.
+func ScalarDiv(v1 Vec4Impl, t float32) Vec4Impl {
+	return Vec4Impl{
+		X: v1.X / t,
+		Y: v1.Y / t,
+		Z: v1.Z / t,
+		W: v1.W / t,
+	}
+}
+
+// Dot computes the dot product of the two supplied vectors.
+func Dot(v1 Vec4Impl, v2 Vec4Impl) float32 {
+	return (v1.X * v2.X) + (v1.Y * v2.Y) + (v1.Z * v2.Z) + (v1.W * v2.W)
+}
+
+// UnitVector returns a unit vector representation of the supplied vector.
+func UnitVector(v Vec4Impl) Vec4Impl {
+	return ScalarDiv(v, v.Length())
+}
+
+// DeNAN ensures that the vector elements are numbers.
+func DeNAN(v Vec4Impl) Vec4Impl {
+	x := v.X
+	y := v.Y
+	z := v.Z
+	w := v.W
+	if math32.IsNaN(x) || math32.IsInf(x, -1) || math32.IsInf(x, 1) {
+		x = 0
+	}
+
+	if math32.IsNaN(y) || math32.IsInf(y, -1) || math32.IsInf(y, 1) {
+		y = 0
+	}
+
+	if math32.IsNaN(z) || math32.IsInf(z, -1) || math32.IsInf(z, 1) {
+		z = 0
+	}
+
+	if math32.IsNaN(w) || math32.IsInf(w, -1) || math32.IsInf(w, 1) {
+		w = 0
+	}
+
+	return Vec4Impl{X: x, Y: y, Z: z, W: w}
+}
+
+// Lerp performs a linear interpolation between the two provided vectors.
+func Lerp(v0, v1 Vec4Impl, t float32) Vec4Impl {
+	return Vec4Impl{
+		X: (1-t)*v0.X + t*v1.X,
+		Y: (1-t)*v0.Y + t*v1.Y,
+		Z: (1-t)*v0.Z + t*v1.Z,
+		W: (1-t)*v0.W + t*v1.W,
+	}
+}
+
+// Equals returns whether two vectors are the same.
+func Equals(v0, v1 Vec4Impl) bool {
+	return v0.X == v1.X &&
+		v0.Y == v1.Y &&
+		v0.Z == v1.Z &&
+		v0.W == v1.W
+}
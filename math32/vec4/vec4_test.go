@@ -0,0 +1,65 @@
+package vec4
+
+import (
+	"testing"
+
+	"github.com/flynn-nrg/go-vfx/math32"
+	"github.com/flynn-nrg/go-vfx/math32/vec3"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOperations(t *testing.T) {
+	a := Vec4Impl{X: 1, Y: 2, Z: 2, W: 4}
+	b := Vec4Impl{X: 1, Y: -2, Z: 4, W: 0.5}
+
+	testData := []struct {
+		name string
+		got  Vec4Impl
+		want Vec4Impl
+	}{
+		{name: "Add", got: Add(a, b, b), want: Vec4Impl{X: 3, Y: -2, Z: 10, W: 5}},
+		{name: "Sub", got: Sub(a, b, b), want: Vec4Impl{X: -1, Y: 6, Z: -6, W: 3}},
+		{name: "Mul", got: Mul(a, b), want: Vec4Impl{X: 1, Y: -4, Z: 8, W: 2}},
+		{name: "Div", got: Div(a, b), want: Vec4Impl{X: 1, Y: -1, Z: 0.5, W: 8}},
+		{name: "ScalarMul", got: ScalarMul(a, 2), want: Vec4Impl{X: 2, Y: 4, Z: 4, W: 8}},
+		{name: "ScalarDiv", got: ScalarDiv(a, 2), want: Vec4Impl{X: 0.5, Y: 1, Z: 1, W: 2}},
+		{name: "UnitVector", got: UnitVector(a), want: Vec4Impl{X: 0.2, Y: 0.4, Z: 0.4, W: 0.8}},
+		{name: "MakeUnitVector", got: a.MakeUnitVector(), want: Vec4Impl{X: 0.2, Y: 0.4, Z: 0.4, W: 0.8}},
+		{name: "Lerp", got: Lerp(a, b, 0.5), want: Vec4Impl{X: 1, Y: 0, Z: 3, W: 2.25}},
+		{name: "DeNAN", got: DeNAN(Vec4Impl{X: math32.NaN(), Y: math32.Inf(1), Z: 3, W: math32.Inf(-1)}), want: Vec4Impl{Z: 3}},
+		{name: "FromVec3", got: FromVec3(vec3.Vec3Impl{X: 1, Y: 2, Z: 3}, 1), want: Vec4Impl{X: 1, Y: 2, Z: 3, W: 1}},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.got); diff != "" {
+				t.Errorf("%s() mismatch (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+}
+
+func TestScalars(t *testing.T) {
+	a := Vec4Impl{X: 1, Y: 2, Z: 2, W: 4}
+	b := Vec4Impl{X: 1, Y: -2, Z: 4, W: 0.5}
+
+	if got := a.Length(); got != 5 {
+		t.Errorf("Length() = %v, want 5", got)
+	}
+
+	if got := a.SquaredLength(); got != 25 {
+		t.Errorf("SquaredLength() = %v, want 25", got)
+	}
+
+	if got := Dot(a, b); got != 7 {
+		t.Errorf("Dot() = %v, want 7", got)
+	}
+
+	if !Equals(a, Vec4Impl{X: 1, Y: 2, Z: 2, W: 4}) || Equals(a, b) {
+		t.Errorf("Equals() returned the wrong result")
+	}
+
+	if diff := cmp.Diff(vec3.Vec3Impl{X: 1, Y: 2, Z: 2}, a.Vec3()); diff != "" {
+		t.Errorf("Vec3() mismatch (-want +got):\n%s", diff)
+	}
+}